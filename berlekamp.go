@@ -0,0 +1,121 @@
+package galois
+
+import "fmt"
+
+// BerlekampMassey finds the shortest linear feedback shift register which
+// generates the given binary sequence, using the Berlekamp-Massey algorithm.
+// Each element of seq must be zero or one.
+//
+// It returns the connection polynomial C(x) = 1 + c1*x + ... + cL*x^L of the
+// register, so that every element of the sequence after the first L satisfies:
+//
+//	seq[n] = c1*seq[n-1] + c2*seq[n-2] + ... + cL*seq[n-L]
+//
+// This is the same form as the feedback polynomial of an lfsr.Fibonacci register.
+//
+// It also returns the linear complexity profile of the sequence: profile[n] is
+// the length of the shortest register which generates the first n+1 elements of
+// seq. The last element of the profile is the linear complexity L of the whole
+// sequence. Note that the degree of C(x) may be less than L.
+//
+// Panics if the linear complexity of seq is greater than 63.
+func BerlekampMassey(seq []uint8) (conn Polynomial, profile []int) {
+	profile = make([]int, len(seq))
+
+	conn = 1
+	prev := Polynomial(1)
+	complexity := 0
+	shift := 1
+
+	for n := range seq {
+		discrepancy := seq[n] & 1
+		for i := 1; i <= complexity; i++ {
+			discrepancy ^= uint8(conn>>i) & seq[n-i] & 1
+		}
+
+		if discrepancy == 0 {
+			shift++
+		} else if 2*complexity <= n {
+			if n+1-complexity > 63 {
+				panic(fmt.Sprintf("linear complexity %d of sequence is greater than 63", n+1-complexity))
+			}
+			next := conn ^ prev<<shift
+			prev = conn
+			conn = next
+			complexity = n + 1 - complexity
+			shift = 1
+		} else {
+			conn ^= prev << shift
+			shift++
+		}
+
+		profile[n] = complexity
+	}
+
+	return
+}
+
+// BerlekampMassey finds the shortest linear feedback shift register over the Field
+// which generates the given sequence of field elements, using the Berlekamp-Massey
+// algorithm.
+//
+// It returns the connection polynomial C(x) = 1 + c1*x + ... + cL*x^L of the
+// register, so that every element of the sequence after the first L satisfies:
+//
+//	seq[n] = c1*seq[n-1] + c2*seq[n-2] + ... + cL*seq[n-L]
+//
+// It also returns the linear complexity profile of the sequence: profile[n] is
+// the length of the shortest register which generates the first n+1 elements of
+// seq. The last element of the profile is the linear complexity L of the whole
+// sequence. The returned polynomial always has exactly L+1 coefficients, though
+// its degree may be less than L.
+func (field *Field[T]) BerlekampMassey(seq []T) (conn FieldPolynomial[T], profile []int) {
+	profile = make([]int, len(seq))
+
+	conn = FieldPolynomial[T]{1}
+	prev := FieldPolynomial[T]{1}
+	prevDiscrepancy := T(1)
+	complexity := 0
+	shift := 1
+
+	for n := range seq {
+		discrepancy := seq[n]
+		for i := 1; i <= complexity && i < len(conn); i++ {
+			discrepancy = field.Add(discrepancy, field.Mul(conn[i], seq[n-i]))
+		}
+
+		if discrepancy == 0 {
+			shift++
+			profile[n] = complexity
+			continue
+		}
+
+		// C(x) = C(x) - (d/b) * x^shift * B(x)
+		scale := field.Div(discrepancy, prevDiscrepancy)
+		next := make(FieldPolynomial[T], len(conn))
+		copy(next, conn)
+		for len(next) < len(prev)+shift {
+			next = append(next, 0)
+		}
+		for i, coeff := range prev {
+			next[i+shift] = field.Sub(next[i+shift], field.Mul(scale, coeff))
+		}
+
+		if 2*complexity <= n {
+			prev = conn
+			prevDiscrepancy = discrepancy
+			complexity = n + 1 - complexity
+			shift = 1
+		} else {
+			shift++
+		}
+		conn = next
+
+		profile[n] = complexity
+	}
+
+	for len(conn) < complexity+1 {
+		conn = append(conn, 0)
+	}
+	return conn[:complexity+1], profile
+}
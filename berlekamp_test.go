@@ -0,0 +1,123 @@
+package galois
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// minimalComplexity finds the linear complexity of a short binary sequence by
+// trying every connection polynomial of increasing length.
+func minimalComplexity(seq []uint8) int {
+	for length := 0; length < len(seq); length++ {
+		for taps := 0; taps < 1<<length; taps++ {
+			ok := true
+			for n := length; n < len(seq) && ok; n++ {
+				var s uint8
+				for i := 1; i <= length; i++ {
+					s ^= uint8(taps>>(i-1)) & seq[n-i] & 1
+				}
+				ok = s == seq[n]
+			}
+			if ok {
+				return length
+			}
+		}
+	}
+	return len(seq)
+}
+
+func TestBerlekampMassey(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for trial := 0; trial < 200; trial++ {
+		seq := make([]uint8, 1+rng.Intn(14))
+		for i := range seq {
+			seq[i] = uint8(rng.Intn(2))
+		}
+
+		conn, profile := BerlekampMassey(seq)
+
+		for n := range seq {
+			if expected := minimalComplexity(seq[:n+1]); profile[n] != expected {
+				t.Errorf("expected linear complexity of %v to be %d, got %d", seq[:n+1], expected, profile[n])
+			}
+		}
+
+		complexity := profile[len(profile)-1]
+		if int(conn.Degree()) > complexity || conn&1 != 1 {
+			t.Errorf("invalid connection polynomial %s for complexity %d", conn, complexity)
+		}
+
+		for n := complexity; n < len(seq); n++ {
+			var s uint8
+			for i := 1; i <= complexity; i++ {
+				s ^= uint8(conn>>i) & seq[n-i] & 1
+			}
+			if s != seq[n] {
+				t.Errorf("connection polynomial %s does not generate %v", conn, seq)
+				break
+			}
+		}
+	}
+}
+
+func TestBerlekampMassey_MSequence(t *testing.T) {
+	// An m-sequence generated by the recurrence s[n] = s[n-3] + s[n-10] has linear
+	// complexity 10, and its connection polynomial is 1 + x^3 + x^10.
+	seq := make([]uint8, 100)
+	for i := 0; i < 10; i++ {
+		seq[i] = 1
+	}
+	for n := 10; n < len(seq); n++ {
+		seq[n] = seq[n-3] ^ seq[n-10]
+	}
+
+	conn, profile := BerlekampMassey(seq)
+	if expected := Polynomial(0b10000001001); conn != expected {
+		t.Errorf("expected connection polynomial %s, got %s", expected, conn)
+	}
+	if complexity := profile[len(profile)-1]; complexity != 10 {
+		t.Errorf("expected linear complexity 10, got %d", complexity)
+	}
+}
+
+func TestField_BerlekampMassey(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+
+	// s[n] = 3*a^n + 7*b^n + c^n has linear complexity 3, and its connection
+	// polynomial has roots at the inverses of a, b and c.
+	a, b, c := field.Generate(5), field.Generate(100), field.Generate(201)
+	seq := make([]uint8, 20)
+	for n := range seq {
+		seq[n] = field.Add(
+			field.Mul(3, field.Exp(a, uint64(n))),
+			field.Mul(7, field.Exp(b, uint64(n))),
+			field.Exp(c, uint64(n)),
+		)
+	}
+
+	conn, profile := field.BerlekampMassey(seq)
+
+	if complexity := profile[len(profile)-1]; complexity != 3 {
+		t.Fatalf("expected linear complexity 3, got %d", complexity)
+	}
+	if len(conn) != 4 || conn[0] != 1 {
+		t.Fatalf("unexpected connection polynomial %v", conn)
+	}
+
+	for _, root := range []uint8{a, b, c} {
+		if v := field.EvalPolynomial(conn, field.MultInverse(root)); v != 0 {
+			t.Errorf("expected connection polynomial to have a root at %d^-1; got %d", root, v)
+		}
+	}
+
+	for n := 3; n < len(seq); n++ {
+		var s uint8
+		for i := 1; i <= 3; i++ {
+			s = field.Add(s, field.Mul(conn[i], seq[n-i]))
+		}
+		if s != seq[n] {
+			t.Errorf("connection polynomial %v does not generate element %d of the sequence", conn, n)
+		}
+	}
+}
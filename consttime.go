@@ -0,0 +1,149 @@
+package galois
+
+// ConstantTimeField performs arithmetic in a finite field without branching on,
+// or indexing memory with, the values of field elements, so that the time taken
+// by each operation does not depend on the secret values it processes. Use it
+// in place of Field when elements are cryptographic secrets.
+//
+// Field is not suitable for secrets: Polynomial.Mul takes a shortcut for powers
+// of x, Polynomial.Div loops a number of times which depends on its operands,
+// Field.Mul returns early on zero, and MultInverse runs the extended euclidean
+// algorithm, whose number of steps depends on the element being inverted.
+//
+// ConstantTimeField instead multiplies with a fixed number of iterations, one
+// per coefficient, which each combine the operands using masks rather than
+// branches, and reduces the product as it goes. Inversion uses Fermat's little
+// theorem, with a fixed sequence of multiplications.
+//
+// The running time of each operation depends only on the degree of the prime
+// polynomial, which is public. Note that this is not a guarantee about the
+// machine code produced by the compiler, nor about the hardware it runs on.
+type ConstantTimeField[T IntLike] struct {
+	field  *Field[T]
+	prime  uint64
+	degree uint64
+}
+
+// NewConstantTimeField creates a ConstantTimeField generated by the given prime
+// polynomial.
+//
+// Panics if the type parameter T is not of sufficient size to represent every
+// element in the field, or if the prime polynomial has degree zero.
+func NewConstantTimeField[T IntLike](prime Polynomial) *ConstantTimeField[T] {
+	field := NewField[T](prime)
+	if prime.Degree() == 0 {
+		panic("cannot create constant-time field with a prime polynomial of degree zero")
+	}
+	return &ConstantTimeField[T]{
+		field:  field,
+		prime:  uint64(prime),
+		degree: prime.Degree(),
+	}
+}
+
+// Field returns the variable-time Field with the same prime polynomial.
+func (ctf *ConstantTimeField[T]) Field() *Field[T] {
+	return ctf.field
+}
+
+// Add computes the sum of the given elements within the finite field.
+func (ctf *ConstantTimeField[T]) Add(values ...T) (sum T) {
+	for _, v := range values {
+		sum ^= v
+	}
+	return
+}
+
+// Sub computes the difference of the given elements (a - b) within the finite field.
+func (ctf *ConstantTimeField[T]) Sub(a, b T) T {
+	return a ^ b
+}
+
+// Mul multiplies a set of field elements and returns the product. If called with
+// no parameters, Mul returns zero.
+//
+// The number of values is not hidden, but their content is.
+func (ctf *ConstantTimeField[T]) Mul(values ...T) T {
+	if len(values) == 0 {
+		return 0
+	}
+	product := uint64(values[0])
+	for _, v := range values[1:] {
+		product = ctf.mul(product, uint64(v))
+	}
+	return T(product)
+}
+
+// mul multiplies a and b, with the coefficients of b processed from highest to
+// lowest degree. Each iteration multiplies the accumulator by x, reduces it by
+// the prime if the coefficient of x^m is set, and adds a if the current
+// coefficient of b is set, with every condition applied by masking.
+func (ctf *ConstantTimeField[T]) mul(a, b uint64) uint64 {
+	var acc uint64
+	for i := int(ctf.degree) - 1; i >= 0; i-- {
+		acc <<= 1
+		acc ^= ctf.prime & -((acc >> ctf.degree) & 1)
+		acc ^= a & -((b >> uint(i)) & 1)
+	}
+	return acc
+}
+
+// Exp raises the base element to the power of exponent, using square and
+// multiply with a masked selection, over all 64 bits of the exponent. Both the
+// base and the exponent are hidden.
+//
+// If exponent is zero, returns the multiplicative identity 1. If base is zero and
+// exponent is not, returns zero.
+func (ctf *ConstantTimeField[T]) Exp(base T, exponent uint64) T {
+	return T(ctf.exp(uint64(base), exponent))
+}
+
+func (ctf *ConstantTimeField[T]) exp(base, exponent uint64) uint64 {
+	result := uint64(1)
+	for i := 63; i >= 0; i-- {
+		result = ctf.mul(result, result)
+		multiplied := ctf.mul(result, base)
+		mask := -((exponent >> uint(i)) & 1)
+		result = (multiplied & mask) | (result &^ mask)
+	}
+	return result
+}
+
+// MultInverse computes the multiplicative inverse of y as y^(2^m - 2), using
+// Fermat's little theorem.
+//
+// Unlike Field.MultInverse, this does not panic if y is zero, because checking
+// for zero would reveal whether y is zero. Instead, the inverse of zero is zero.
+func (ctf *ConstantTimeField[T]) MultInverse(y T) T {
+	// The exponent is public, so it needs no masking: square m-1 times, and
+	// multiply after every squaring but the last.
+	a := uint64(y)
+	result := a
+	for i := uint64(1); i < ctf.degree-1; i++ {
+		result = ctf.mul(ctf.mul(result, result), a)
+	}
+	if ctf.degree > 1 {
+		result = ctf.mul(result, result)
+	}
+	return T(result)
+}
+
+// Div returns the division of the numerator element by the denominator element.
+//
+// If denominator is zero, returns zero. See MultInverse.
+func (ctf *ConstantTimeField[T]) Div(numerator, denominator T) T {
+	return T(ctf.mul(uint64(numerator), uint64(ctf.MultInverse(denominator))))
+}
+
+// Equal returns 1 if a and b are equal and 0 otherwise, without branching.
+func (ctf *ConstantTimeField[T]) Equal(a, b T) T {
+	diff := uint64(a ^ b)
+	return T(1 ^ ((diff | -diff) >> 63))
+}
+
+// Select returns a if choice is 1, and b if choice is 0, without branching.
+// choice must be either 0 or 1.
+func (ctf *ConstantTimeField[T]) Select(choice, a, b T) T {
+	mask := -uint64(choice)
+	return T((uint64(a) & mask) | (uint64(b) &^ mask))
+}
@@ -0,0 +1,171 @@
+package galois
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestConstantTimeField_MatchesField(t *testing.T) {
+	ctf := NewConstantTimeField[uint8](PrimePolynomialDegree8)
+	field := ctf.Field()
+
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			if product := ctf.Mul(uint8(a), uint8(b)); product != field.Mul(uint8(a), uint8(b)) {
+				t.Fatalf("expected %d * %d = %d, got %d", a, b, field.Mul(uint8(a), uint8(b)), product)
+			}
+		}
+
+		if a == 0 {
+			if ctf.MultInverse(0) != 0 {
+				t.Errorf("expected inverse of zero to be zero")
+			}
+			continue
+		}
+		if inverse := ctf.MultInverse(uint8(a)); inverse != field.MultInverse(uint8(a)) {
+			t.Errorf("expected inverse of %d to be %d, got %d", a, field.MultInverse(uint8(a)), inverse)
+		}
+	}
+}
+
+func TestConstantTimeField_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, prime := range []Polynomial{PrimePolynomialDegree2, PrimePolynomialDegree17, PrimePolynomialDegree32} {
+		ctf := NewConstantTimeField[uint32](prime)
+		field := ctf.Field()
+		mask := uint32(field.Order() - 1)
+
+		for trial := 0; trial < 200; trial++ {
+			a, b := rng.Uint32()&mask, rng.Uint32()&mask|1
+			e := rng.Uint64()
+
+			if ctf.Mul(a, b) != field.Mul(a, b) {
+				t.Fatalf("expected products of %d and %d to match", a, b)
+			}
+			if ctf.Div(a, b) != field.Div(a, b) {
+				t.Fatalf("expected quotients of %d and %d to match", a, b)
+			}
+			if ctf.Exp(b, e) != field.Exp(b, e) {
+				t.Fatalf("expected %d^%d to match", b, e)
+			}
+		}
+
+		if ctf.Exp(0, 0) != 1 || ctf.Exp(0, 5) != 0 {
+			t.Errorf("expected 0^0 = 1 and 0^5 = 0")
+		}
+	}
+}
+
+func TestConstantTimeField_Select(t *testing.T) {
+	ctf := NewConstantTimeField[uint16](PrimePolynomialDegree16)
+
+	if ctf.Equal(0x1234, 0x1234) != 1 || ctf.Equal(0x1234, 0x1235) != 0 || ctf.Equal(0, 0xFFFF) != 0 {
+		t.Errorf("Equal returned incorrect results")
+	}
+	if ctf.Select(1, 0xAAAA, 0x5555) != 0xAAAA || ctf.Select(0, 0xAAAA, 0x5555) != 0x5555 {
+		t.Errorf("Select returned incorrect results")
+	}
+}
+
+// welchT returns Welch's t-statistic for the difference between the means of
+// two samples.
+func welchT(a, b []float64) float64 {
+	meanVar := func(xs []float64) (mean, variance float64) {
+		for _, x := range xs {
+			mean += x
+		}
+		mean /= float64(len(xs))
+		for _, x := range xs {
+			variance += (x - mean) * (x - mean)
+		}
+		variance /= float64(len(xs) - 1)
+		return
+	}
+
+	meanA, varA := meanVar(a)
+	meanB, varB := meanVar(b)
+	return (meanA - meanB) / math.Sqrt(varA/float64(len(a))+varB/float64(len(b)))
+}
+
+// measureTimingLeak runs op on inputs drawn from two classes, a fixed class and
+// a random class, in a random order, and returns Welch's t-statistic for the
+// difference between their running times, in the style of dudect. Measurements
+// above a high percentile are discarded, to reduce the effect of interrupts.
+func measureTimingLeak(rng *rand.Rand, samples int, fixed, random func() uint32, op func(uint32)) float64 {
+	const batch = 32
+	inputs := make([]uint32, batch)
+	var times [2][]float64
+
+	for i := 0; i < samples; i++ {
+		class := rng.Intn(2)
+		for j := range inputs {
+			if class == 0 {
+				inputs[j] = fixed()
+			} else {
+				inputs[j] = random()
+			}
+		}
+
+		start := time.Now()
+		for _, v := range inputs {
+			op(v)
+		}
+		times[class] = append(times[class], float64(time.Since(start)))
+	}
+
+	all := append(append([]float64(nil), times[0]...), times[1]...)
+	sort.Float64s(all)
+	cutoff := all[len(all)*9/10]
+
+	var cropped [2][]float64
+	for class := range times {
+		for _, x := range times[class] {
+			if x <= cutoff {
+				cropped[class] = append(cropped[class], x)
+			}
+		}
+	}
+	return welchT(cropped[0], cropped[1])
+}
+
+func TestConstantTimeField_Timing(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping statistical timing test in short mode")
+	}
+
+	// dudect treats |t| > 4.5 as evidence of a leak. A more generous threshold
+	// avoids spurious failures on noisy machines, while still catching gross
+	// data-dependent timing such as an early return on zero.
+	const threshold = 10
+
+	rng := rand.New(rand.NewSource(1))
+	ctf := NewConstantTimeField[uint32](PrimePolynomialDegree32)
+
+	var sink uint32
+	random := func() uint32 { return rng.Uint32() }
+	zero := func() uint32 { return 0 }
+	one := func() uint32 { return 1 }
+
+	tests := []struct {
+		name  string
+		fixed func() uint32
+		op    func(uint32)
+	}{
+		{"Mul by zero", zero, func(v uint32) { sink ^= ctf.Mul(v, 0x9E3779B9) }},
+		{"Mul by one", one, func(v uint32) { sink ^= ctf.Mul(0x9E3779B9, v) }},
+		{"MultInverse of one", one, func(v uint32) { sink ^= ctf.MultInverse(v) }},
+		{"Exp with zero exponent", zero, func(v uint32) { sink ^= ctf.Exp(0x9E3779B9, uint64(v)) }},
+	}
+
+	for _, test := range tests {
+		tStat := measureTimingLeak(rng, 1500, test.fixed, random, test.op)
+		if math.Abs(tStat) > threshold {
+			t.Errorf("%s: timing differs between fixed and random inputs; t = %.2f", test.name, tStat)
+		}
+	}
+	_ = sink
+}
@@ -0,0 +1,86 @@
+// Package crc implements parameterized cyclic redundancy checks using the
+// Rocksoft model, along with a search which recovers CRC parameters from
+// sample messages and their checksums.
+package crc
+
+import (
+	"math/bits"
+
+	"github.com/kklash/galois"
+)
+
+// Model describes a CRC algorithm using the parameters of the Rocksoft model,
+// as catalogued by CRC RevEng.
+type Model struct {
+	// Width is the number of bits in the checksum, between 1 and 64. It is also
+	// the degree of the generator polynomial.
+	Width int
+
+	// Poly is the generator polynomial of the CRC, without its highest-degree term
+	// x^Width, which is implied. This is the normal notation used by CRC RevEng, and
+	// allows 64-bit CRCs to be described. For example, CRC-32 uses the polynomial
+	// x^32 + 0x04C11DB7.
+	Poly galois.Polynomial
+
+	// Init is the value of the CRC register before any message bits are processed.
+	Init uint64
+
+	// RefIn indicates that each input byte is bit-reversed before processing,
+	// so that the least-significant bit of each byte is processed first.
+	RefIn bool
+
+	// RefOut indicates that the final register value is bit-reversed over the width
+	// of the CRC before being XORed with XorOut.
+	RefOut bool
+
+	// XorOut is XORed with the final register value to produce the checksum.
+	XorOut uint64
+}
+
+// Checksum computes the CRC of the given data.
+//
+// Panics if model.Width is not between 1 and 64, or if model.Poly has degree
+// model.Width or greater.
+func (model Model) Checksum(data []byte) uint64 {
+	if model.Width < 1 || model.Width > 64 {
+		panic("crc: width must be between 1 and 64")
+	}
+	if model.Width < 64 && model.Poly>>model.Width != 0 {
+		panic("crc: polynomial must not include its highest-degree term")
+	}
+
+	reg := register(model.Width, model.Poly, model.Init, data, model.RefIn)
+	if model.RefOut {
+		reg = reflect(reg, model.Width)
+	}
+	return reg ^ model.XorOut
+}
+
+// register runs the CRC register of the given width, whose generator polynomial
+// is x^width + poly, over the given data, starting from the given initial value,
+// and returns the final register value. This is
+// (init * x^len(data) + data * x^width) mod (x^width + poly).
+func register(width int, poly galois.Polynomial, init uint64, data []byte, refIn bool) uint64 {
+	// When width is 64, the shift produces zero, and the mask has every bit set.
+	mask := uint64(1)<<width - 1
+	reg := init & mask
+
+	for _, b := range data {
+		if refIn {
+			b = bits.Reverse8(b)
+		}
+		for i := 7; i >= 0; i-- {
+			top := (reg >> (width - 1)) & 1
+			reg = (reg << 1) & mask
+			if top^(uint64(b>>i)&1) == 1 {
+				reg ^= uint64(poly) & mask
+			}
+		}
+	}
+	return reg
+}
+
+// reflect reverses the lowest width bits of v.
+func reflect(v uint64, width int) uint64 {
+	return bits.Reverse64(v) >> (64 - width)
+}
@@ -0,0 +1,79 @@
+package crc
+
+import "testing"
+
+func TestModel_Checksum(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		Model    Model
+		Expected uint64
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "CRC-8",
+			Model:    Model{Width: 8, Poly: 0x07},
+			Expected: 0xF4,
+		},
+		{
+			Name:     "CRC-16/ARC",
+			Model:    Model{Width: 16, Poly: 0x8005, RefIn: true, RefOut: true},
+			Expected: 0xBB3D,
+		},
+		{
+			Name:     "CRC-16/IBM-3740",
+			Model:    Model{Width: 16, Poly: 0x1021, Init: 0xFFFF},
+			Expected: 0x29B1,
+		},
+		{
+			Name:     "CRC-16/MODBUS",
+			Model:    Model{Width: 16, Poly: 0x8005, Init: 0xFFFF, RefIn: true, RefOut: true},
+			Expected: 0x4B37,
+		},
+		{
+			Name:     "CRC-32/ISO-HDLC",
+			Model:    Model{Width: 32, Poly: 0x04C11DB7, Init: 0xFFFFFFFF, RefIn: true, RefOut: true, XorOut: 0xFFFFFFFF},
+			Expected: 0xCBF43926,
+		},
+		{
+			Name:     "CRC-32/BZIP2",
+			Model:    Model{Width: 32, Poly: 0x04C11DB7, Init: 0xFFFFFFFF, XorOut: 0xFFFFFFFF},
+			Expected: 0xFC891918,
+		},
+		{
+			Name:     "CRC-40/GSM",
+			Model:    Model{Width: 40, Poly: 0x0004820009, XorOut: 0xFFFFFFFFFF},
+			Expected: 0xD4164FC646,
+		},
+		{
+			Name:     "CRC-64/ECMA-182",
+			Model:    Model{Width: 64, Poly: 0x42F0E1EBA9EA3693},
+			Expected: 0x6C40DF5F0B497347,
+		},
+		{
+			Name:     "CRC-64/GO-ISO",
+			Model:    Model{Width: 64, Poly: 0x1B, Init: 0xFFFFFFFFFFFFFFFF, RefIn: true, RefOut: true, XorOut: 0xFFFFFFFFFFFFFFFF},
+			Expected: 0xB90956C775A41001,
+		},
+		{
+			Name:     "CRC-64/XZ",
+			Model:    Model{Width: 64, Poly: 0x42F0E1EBA9EA3693, Init: 0xFFFFFFFFFFFFFFFF, RefIn: true, RefOut: true, XorOut: 0xFFFFFFFFFFFFFFFF},
+			Expected: 0x995DC9BBDF1939FA,
+		},
+	}
+
+	for _, test := range testCases {
+		if checksum := test.Model.Checksum([]byte("123456789")); checksum != test.Expected {
+			t.Errorf("%s: expected check value 0x%X, got 0x%X", test.Name, test.Expected, checksum)
+		}
+	}
+}
+
+func TestModel_Checksum_InvalidPoly(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic for polynomial including its highest-degree term")
+		}
+	}()
+	Model{Width: 8, Poly: 0x107}.Checksum([]byte("123456789"))
+}
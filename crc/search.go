@@ -0,0 +1,336 @@
+package crc
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+
+	"github.com/kklash/galois"
+)
+
+// maxCofactorDegree bounds how many surplus degrees the GCD of the sample
+// differences may have before Search gives up enumerating its divisors.
+const maxCofactorDegree = 12
+
+// maxAmbiguousInitBits bounds how many bits of Init may be left undetermined by
+// the samples before Search stops enumerating every possible value of Init.
+const maxAmbiguousInitBits = 4
+
+var (
+	// ErrInvalidWidth is returned by Search if the requested CRC width is not
+	// between 1 and 64 bits.
+	ErrInvalidWidth = errors.New("crc: width must be between 1 and 64")
+
+	// ErrInsufficientSamples is returned by Search if the given samples do not
+	// contain enough information to determine the CRC polynomial. Search needs at
+	// least two distinct messages of the same length. More samples are needed
+	// if the GCD of their differences has a degree much larger than the width.
+	ErrInsufficientSamples = errors.New("crc: insufficient samples to determine polynomial")
+)
+
+// Sample is a message paired with the checksum some unknown CRC computed for it.
+type Sample struct {
+	Message  []byte
+	Checksum uint64
+}
+
+// Search recovers the parameters of every CRC Model of the given width which
+// produces the expected checksum for each of the given samples.
+//
+// Rather than brute-forcing the polynomial, Search uses the linearity of CRCs:
+// for two messages a and b of the same length, the XOR of their checksums
+// depends only on a XOR b, so that
+//
+//	(a + b) * x^width + (crc(a) + crc(b))
+//
+// is always a multiple of the CRC polynomial. The greatest common divisor of
+// many such differences reveals the polynomial. Once it is known, Init and XorOut
+// are found by solving a linear system over GF(2), using samples of different
+// lengths. If every sample has the same length, Init and XorOut cannot be told
+// apart, and Search reports models with an Init of zero. Some polynomials, such as
+// those divisible by x+1, admit a few values of Init which no set of samples can
+// distinguish; Search reports all of them.
+//
+// Every combination of RefIn and RefOut is tried. Messages of the same length
+// are required to find the polynomial; the more distinct samples are provided,
+// the less likely Search is to report spurious models or ErrInsufficientSamples.
+func Search(width int, samples []Sample) ([]Model, error) {
+	if width < 1 || width > 64 {
+		return nil, ErrInvalidWidth
+	}
+
+	var (
+		models       []Model
+		undetermined bool
+	)
+
+	for _, refIn := range []bool{false, true} {
+		for _, refOut := range []bool{false, true} {
+			gcd := differenceGCD(width, samples, refIn, refOut)
+			if gcd.Sign() == 0 {
+				undetermined = true
+				continue
+			}
+
+			polys, ok := divisorsOfDegree(gcd, width)
+			if !ok {
+				undetermined = true
+				continue
+			}
+
+			for _, poly := range polys {
+				models = append(models, solveModels(width, poly, samples, refIn, refOut)...)
+			}
+		}
+	}
+
+	if len(models) == 0 && undetermined {
+		return nil, ErrInsufficientSamples
+	}
+	return models, nil
+}
+
+// differenceGCD returns the GCD of the difference polynomials of every pair of
+// same-length samples, or zero if there are no such pairs.
+func differenceGCD(width int, samples []Sample, refIn, refOut bool) *big.Int {
+	mask := uint64(1)<<width - 1
+	gcd := new(big.Int)
+
+	firstOfLength := make(map[int]Sample)
+	for _, sample := range samples {
+		first, ok := firstOfLength[len(sample.Message)]
+		if !ok {
+			firstOfLength[len(sample.Message)] = sample
+			continue
+		}
+
+		msgDiff := make([]byte, len(sample.Message))
+		for i := range msgDiff {
+			msgDiff[i] = sample.Message[i] ^ first.Message[i]
+			if refIn {
+				msgDiff[i] = bits.Reverse8(msgDiff[i])
+			}
+		}
+
+		crcDiff := (sample.Checksum ^ first.Checksum) & mask
+		if refOut {
+			crcDiff = reflect(crcDiff, width)
+		}
+
+		diff := new(big.Int).SetBytes(msgDiff)
+		diff.Lsh(diff, uint(width))
+		diff.Xor(diff, new(big.Int).SetUint64(crcDiff))
+
+		gcd = polyGCD(gcd, diff)
+	}
+
+	return gcd
+}
+
+// divisorsOfDegree returns every divisor of g with the given degree, without
+// its highest-degree term, as in Model.Poly. Returns false if g has too high a
+// degree for its divisors to be enumerated.
+func divisorsOfDegree(g *big.Int, degree int) ([]galois.Polynomial, bool) {
+	surplus := g.BitLen() - 1 - degree
+	if surplus < 0 {
+		return nil, true
+	} else if surplus == 0 {
+		return []galois.Polynomial{withoutTopTerm(g, degree)}, true
+	} else if surplus > maxCofactorDegree {
+		return nil, false
+	}
+
+	var divisors []galois.Polynomial
+	for cofactor := int64(1) << surplus; cofactor < int64(1)<<(surplus+1); cofactor++ {
+		quotient, remainder := polyDiv(g, big.NewInt(cofactor))
+		if remainder.Sign() == 0 {
+			divisors = append(divisors, withoutTopTerm(quotient, degree))
+		}
+	}
+	return divisors, true
+}
+
+// withoutTopTerm returns the polynomial p of the given degree, up to 64, with its
+// highest-degree term removed.
+func withoutTopTerm(p *big.Int, degree int) galois.Polynomial {
+	return galois.Polynomial(new(big.Int).SetBit(p, degree, 0).Uint64())
+}
+
+// solveModels finds every combination of Init and XorOut for the given width,
+// polynomial and reflection parameters which reproduces the checksum of every
+// sample.
+func solveModels(width int, poly galois.Polynomial, samples []Sample, refIn, refOut bool) []Model {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	unreflect := func(v uint64) uint64 {
+		if refOut {
+			return reflect(v, width)
+		}
+		return v
+	}
+
+	// The register of a sample is linear in Init:
+	//
+	//	register(init, msg) = register(init, zeros) + register(0, msg)
+	//
+	// Differencing the reference sample against each sample of a different length
+	// removes XorOut, leaving a linear system in the bits of Init.
+	ref := samples[0]
+	refZeros := make([]byte, len(ref.Message))
+	refReg := register(width, poly, 0, ref.Message, refIn)
+
+	var (
+		rows []uint64
+		rhs  []uint64
+	)
+	for _, sample := range samples[1:] {
+		if len(sample.Message) == len(ref.Message) {
+			continue
+		}
+		zeros := make([]byte, len(sample.Message))
+
+		var columns [64]uint64
+		for i := 0; i < width; i++ {
+			columns[i] = register(width, poly, 1<<i, zeros, refIn) ^ register(width, poly, 1<<i, refZeros, refIn)
+		}
+
+		target := unreflect(sample.Checksum^ref.Checksum) ^
+			register(width, poly, 0, sample.Message, refIn) ^ refReg
+
+		for j := 0; j < width; j++ {
+			var row uint64
+			for i := 0; i < width; i++ {
+				row |= ((columns[i] >> j) & 1) << i
+			}
+			rows = append(rows, row)
+			rhs = append(rhs, (target>>j)&1)
+		}
+	}
+
+	init, kernel, ok := solveLinear(rows, rhs, width)
+	if !ok {
+		return nil
+	}
+
+	// Some polynomials, such as those divisible by x+1, admit several values of Init
+	// which no choice of message lengths can distinguish. Report each of them, unless
+	// there are so many that the samples clearly do not constrain Init at all.
+	inits := []uint64{init}
+	if len(kernel) <= maxAmbiguousInitBits {
+		for _, v := range kernel {
+			for _, prev := range inits {
+				inits = append(inits, prev^v)
+			}
+		}
+	}
+
+	var models []Model
+	for _, init := range inits {
+		model := Model{
+			Width:  width,
+			Poly:   poly,
+			Init:   init,
+			RefIn:  refIn,
+			RefOut: refOut,
+			XorOut: ref.Checksum ^ unreflect(register(width, poly, init, ref.Message, refIn)),
+		}
+
+		valid := true
+		for _, sample := range samples {
+			if model.Checksum(sample.Message) != sample.Checksum {
+				valid = false
+				break
+			}
+		}
+		if valid {
+			models = append(models, model)
+		}
+	}
+	return models
+}
+
+// solveLinear solves a system of linear equations over GF(2) in n unknowns. Each
+// equation states that the parity of rows[i] & x is rhs[i]. It returns the solution
+// with all free variables set to zero, along with a basis for the kernel of the
+// system. Every other solution is the XOR of x with some subset of the kernel.
+// Returns false if the system is inconsistent.
+func solveLinear(rows, rhs []uint64, n int) (x uint64, kernel []uint64, ok bool) {
+	rows = append([]uint64(nil), rows...)
+	rhs = append([]uint64(nil), rhs...)
+
+	pivotCols := make([]int, 0, n)
+	var free []int
+
+	rank := 0
+	for col := 0; col < n; col++ {
+		pivot := -1
+		for r := rank; r < len(rows); r++ {
+			if (rows[r]>>col)&1 == 1 {
+				pivot = r
+				break
+			}
+		}
+		if pivot < 0 {
+			free = append(free, col)
+			continue
+		}
+
+		rows[rank], rows[pivot] = rows[pivot], rows[rank]
+		rhs[rank], rhs[pivot] = rhs[pivot], rhs[rank]
+		for r := range rows {
+			if r != rank && (rows[r]>>col)&1 == 1 {
+				rows[r] ^= rows[rank]
+				rhs[r] ^= rhs[rank]
+			}
+		}
+
+		pivotCols = append(pivotCols, col)
+		rank++
+	}
+
+	for r := rank; r < len(rows); r++ {
+		if rhs[r] != 0 {
+			return 0, nil, false
+		}
+	}
+
+	for r, col := range pivotCols {
+		x |= rhs[r] << col
+	}
+
+	for _, f := range free {
+		v := uint64(1) << f
+		for r, col := range pivotCols {
+			v |= ((rows[r] >> f) & 1) << col
+		}
+		kernel = append(kernel, v)
+	}
+	return x, kernel, true
+}
+
+// polyDiv divides the binary polynomial a by b, returning the quotient and remainder.
+// Polynomials are represented by the bits of a big.Int, as with galois.Polynomial.
+func polyDiv(a, b *big.Int) (quotient, remainder *big.Int) {
+	quotient = new(big.Int)
+	remainder = new(big.Int).Set(a)
+
+	bLen := b.BitLen()
+	shifted := new(big.Int)
+	for remainder.BitLen() >= bLen {
+		shift := remainder.BitLen() - bLen
+		quotient.SetBit(quotient, shift, 1)
+		remainder.Xor(remainder, shifted.Lsh(b, uint(shift)))
+	}
+	return
+}
+
+// polyGCD returns the greatest common divisor of the binary polynomials a and b.
+func polyGCD(a, b *big.Int) *big.Int {
+	for b.Sign() != 0 {
+		_, remainder := polyDiv(a, b)
+		a, b = b, remainder
+	}
+	return a
+}
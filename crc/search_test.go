@@ -0,0 +1,98 @@
+package crc
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	models := []Model{
+		{Width: 8, Poly: 0x07},
+		{Width: 8, Poly: 0x31, RefIn: true, RefOut: true},
+		{Width: 16, Poly: 0x1021, Init: 0xFFFF},
+		{Width: 16, Poly: 0x8005, Init: 0xFFFF, RefIn: true, RefOut: true},
+		{Width: 16, Poly: 0x1021, Init: 0x1D0F, XorOut: 0xFFFF},
+		{Width: 32, Poly: 0x04C11DB7, Init: 0xFFFFFFFF, RefIn: true, RefOut: true, XorOut: 0xFFFFFFFF},
+		{Width: 32, Poly: 0x1EDC6F41, Init: 0xFFFFFFFF, RefIn: true, RefOut: true, XorOut: 0xFFFFFFFF},
+		{Width: 40, Poly: 0x0004820009, XorOut: 0xFFFFFFFFFF},
+		{Width: 64, Poly: 0x42F0E1EBA9EA3693},
+		{Width: 64, Poly: 0x42F0E1EBA9EA3693, Init: 0xFFFFFFFFFFFFFFFF, RefIn: true, RefOut: true, XorOut: 0xFFFFFFFFFFFFFFFF},
+	}
+
+	rng := rand.New(rand.NewSource(1))
+
+	for _, model := range models {
+		var samples []Sample
+		for _, length := range []int{12, 12, 12, 12, 12, 7, 30} {
+			msg := make([]byte, length)
+			rng.Read(msg)
+			samples = append(samples, Sample{Message: msg, Checksum: model.Checksum(msg)})
+		}
+
+		found, err := Search(model.Width, samples)
+		if err != nil {
+			t.Errorf("failed to search for model %+v: %s", model, err)
+			continue
+		}
+
+		matched := false
+		for _, m := range found {
+			matched = matched || m == model
+			for _, sample := range samples {
+				if checksum := m.Checksum(sample.Message); checksum != sample.Checksum {
+					t.Errorf("found model %+v does not reproduce checksum 0x%X (got 0x%X)", m, sample.Checksum, checksum)
+				}
+			}
+		}
+		if !matched {
+			t.Errorf("expected to find model %+v\ngot %+v", model, found)
+		}
+	}
+}
+
+func TestSearch_SameLength(t *testing.T) {
+	model := Model{Width: 16, Poly: 0x1021, Init: 0xFFFF}
+	rng := rand.New(rand.NewSource(2))
+
+	var samples []Sample
+	for i := 0; i < 5; i++ {
+		msg := make([]byte, 16)
+		rng.Read(msg)
+		samples = append(samples, Sample{Message: msg, Checksum: model.Checksum(msg)})
+	}
+
+	found, err := Search(16, samples)
+	if err != nil {
+		t.Fatalf("failed to search for model: %s", err)
+	}
+
+	if len(found) != 1 {
+		t.Fatalf("expected to find one model, got %+v", found)
+	}
+	if found[0].Poly != model.Poly || found[0].Init != 0 {
+		t.Errorf("expected polynomial %s with Init zero; got %+v", model.Poly, found[0])
+	}
+
+	for _, sample := range samples {
+		if checksum := found[0].Checksum(sample.Message); checksum != sample.Checksum {
+			t.Errorf("found model does not reproduce checksum 0x%X (got 0x%X)", sample.Checksum, checksum)
+		}
+	}
+}
+
+func TestSearch_InsufficientSamples(t *testing.T) {
+	model := Model{Width: 32, Poly: 0x04C11DB7}
+	samples := []Sample{
+		{Message: []byte("hello"), Checksum: model.Checksum([]byte("hello"))},
+		{Message: []byte("world!"), Checksum: model.Checksum([]byte("world!"))},
+	}
+
+	if _, err := Search(32, samples); !errors.Is(err, ErrInsufficientSamples) {
+		t.Errorf("expected ErrInsufficientSamples, got %v", err)
+	}
+
+	if _, err := Search(65, samples); !errors.Is(err, ErrInvalidWidth) {
+		t.Errorf("expected ErrInvalidWidth, got %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package galois
+
+import "errors"
+
+// Sentinel errors returned by this package, including by the error-returning
+// counterparts of operations which otherwise panic, such as TryNewField and
+// Field.TryDiv. Every error returned by this package wraps one of these, and
+// can be tested with errors.Is.
+var (
+	// ErrDivisionByZero is returned when dividing by zero, inverting zero, or
+	// taking the logarithm of zero.
+	ErrDivisionByZero = errors.New("division by zero")
+
+	// ErrNotIrreducible is returned when a polynomial must be irreducible, such as
+	// the prime polynomial of a Field, but is not.
+	ErrNotIrreducible = errors.New("polynomial is not irreducible")
+
+	// ErrNotPrimitive is returned when a polynomial must be primitive, so that
+	// the Generator x generates every non-zero element of a Field, but is not.
+	ErrNotPrimitive = errors.New("polynomial is not primitive")
+
+	// ErrInvalidPrime is returned when a polynomial cannot be used as the prime
+	// polynomial of a Field, regardless of its irreducibility, such as a
+	// polynomial of degree 0 or 1.
+	ErrInvalidPrime = errors.New("invalid prime polynomial")
+
+	// ErrInvalidPolynomial is returned when text cannot be parsed as a Polynomial.
+	ErrInvalidPolynomial = errors.New("invalid polynomial")
+
+	// ErrOverflow is returned when a result cannot be represented, such as a
+	// Polynomial product of degree greater than 63, or a Field whose elements do
+	// not fit in its type parameter.
+	ErrOverflow = errors.New("overflow")
+
+	// ErrNoLogarithm is returned by Field.Log when an element is not a power of
+	// the Generator, because the Field's prime polynomial is not primitive.
+	ErrNoLogarithm = errors.New("element is not a power of the generator")
+
+	// ErrElementOutOfRange is returned when a value passed to a Field operation is
+	// not an element of the Field, because it is negative or not less than the
+	// Field's order.
+	ErrElementOutOfRange = errors.New("element out of range")
+)
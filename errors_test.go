@@ -0,0 +1,118 @@
+package galois
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTryNewField(t *testing.T) {
+	type TestCase struct {
+		Prime Polynomial
+		Err   error
+	}
+
+	testCases := []TestCase{
+		{Prime: PrimePolynomialDegree8, Err: nil},
+		{Prime: 0x11B, Err: nil},
+		{Prime: PrimePolynomialDegree17, Err: ErrOverflow}, // too big for uint16
+		{Prime: 1 << 40, Err: ErrOverflow},                 // degree above 32
+		{Prime: 0x101, Err: ErrNotIrreducible},             // (x + 1)^8
+		{Prime: 0, Err: ErrInvalidPrime},                   // zero polynomial
+		{Prime: 0b10, Err: ErrInvalidPrime},                // x
+		{Prime: 0b11, Err: ErrInvalidPrime},                // x + 1
+		{Prime: 0b1111, Err: ErrNotIrreducible},            // (x + 1)(x^2 + 1)
+		{Prime: PrimePolynomialDegree16, Err: nil},
+	}
+
+	for _, test := range testCases {
+		field, err := TryNewField[uint16](test.Prime)
+		if !errors.Is(err, test.Err) || (test.Err != nil) != (err != nil) {
+			t.Errorf("expected TryNewField(%s) to return error %v, got %v", test.Prime, test.Err, err)
+		}
+		if err == nil && field.Prime != test.Prime {
+			t.Errorf("expected field with prime %s, got %s", test.Prime, field.Prime)
+		}
+	}
+}
+
+func TestField_TryMultInverse(t *testing.T) {
+	field := NewField[int16](PrimePolynomialDegree8)
+
+	for a := int16(1); a < 256; a++ {
+		inverse, err := field.TryMultInverse(a)
+		if err != nil || inverse != field.MultInverse(a) {
+			t.Errorf("expected inverse of %d to be %d, got %d (%v)", a, field.MultInverse(a), inverse, err)
+		}
+	}
+
+	if _, err := field.TryMultInverse(0); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("expected ErrDivisionByZero, got %v", err)
+	}
+	for _, value := range []int16{256, 1000, -1} {
+		if _, err := field.TryMultInverse(value); !errors.Is(err, ErrElementOutOfRange) {
+			t.Errorf("expected ErrElementOutOfRange for %d, got %v", value, err)
+		}
+	}
+
+	// x^2 + 1 = (x + 1)^2, so x + 1 has no inverse modulo it.
+	reducible := &Field[uint8]{Prime: 0b101}
+	for _, method := range []InversionMethod{InversionEuclid, InversionFermat, InversionItohTsujii} {
+		reducible.Inversion = method
+		if _, err := reducible.TryMultInverse(0b11); !errors.Is(err, ErrNotIrreducible) {
+			t.Errorf("expected ErrNotIrreducible using %s inversion, got %v", method, err)
+		}
+	}
+}
+
+func TestField_TryDiv(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree4)
+
+	quotient, err := field.TryDiv(7, 3)
+	if err != nil || quotient != field.Div(7, 3) {
+		t.Errorf("expected 7 / 3 = %d, got %d (%v)", field.Div(7, 3), quotient, err)
+	}
+	if _, err := field.TryDiv(7, 0); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("expected ErrDivisionByZero, got %v", err)
+	}
+	if _, err := field.TryDiv(16, 3); !errors.Is(err, ErrElementOutOfRange) {
+		t.Errorf("expected ErrElementOutOfRange for numerator, got %v", err)
+	}
+	if _, err := field.TryDiv(3, 16); !errors.Is(err, ErrElementOutOfRange) {
+		t.Errorf("expected ErrElementOutOfRange for denominator, got %v", err)
+	}
+}
+
+func TestPolynomial_TryMul(t *testing.T) {
+	product, err := Polynomial(0b11).TryMul(0b101)
+	if err != nil || product != 0b1111 {
+		t.Errorf("expected product 0b1111, got %b (%v)", product, err)
+	}
+
+	if _, err := Polynomial(1 << 32).TryMul(1<<32 | 1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", err)
+	}
+	if _, err := Polynomial(1 << 40).TryMul(1 << 30); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow for power of x, got %v", err)
+	}
+	if product, err := Polynomial(0).TryMul(1 << 63); err != nil || product != 0 {
+		t.Errorf("expected zero product without error, got %b (%v)", product, err)
+	}
+}
+
+func TestPolynomial_TryDiv(t *testing.T) {
+	quotient, remainder, err := Polynomial(0b1111).TryDiv(0b11)
+	if err != nil || quotient != 0b101 || remainder != 0 {
+		t.Errorf("expected quotient 0b101 and remainder 0, got %b, %b (%v)", quotient, remainder, err)
+	}
+	if _, _, err := Polynomial(0b1111).TryDiv(0); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("expected ErrDivisionByZero, got %v", err)
+	}
+
+	remainder, err = Polynomial(0b1011).TryMod(0b11)
+	if err != nil || remainder != 1 {
+		t.Errorf("expected remainder 1, got %b (%v)", remainder, err)
+	}
+	if _, err := Polynomial(0b1011).TryMod(0); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("expected ErrDivisionByZero, got %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/kklash/galois"
+	"github.com/kklash/galois/lfsr"
 )
 
 // This example demonstrates the construction and use of a Finite
@@ -70,3 +71,22 @@ func ExampleField_Mul() {
 	// field1: 0x1234 * 0x5678 = 0x6324
 	// field2: 0x1234 * 0x5678 = 0xA051
 }
+
+// This example demonstrates recovering the feedback polynomial of a linear
+// feedback shift register from a short sample of its output.
+func ExampleBerlekampMassey() {
+	register := lfsr.NewFibonacci(galois.PrimePolynomialDegree16, 0xACE1)
+
+	seq := make([]uint8, 64)
+	for i := range seq {
+		seq[i] = register.Bit()
+	}
+
+	conn, profile := galois.BerlekampMassey(seq)
+	fmt.Println(conn)
+	fmt.Println("linear complexity:", profile[len(profile)-1])
+
+	// output:
+	// x^16 + x^12 + x^3 + x + 1
+	// linear complexity: 16
+}
@@ -2,6 +2,7 @@ package galois
 
 import (
 	"fmt"
+	"math/big"
 )
 
 // Generator is the primitive generator polynomial element used to generate all
@@ -14,6 +15,19 @@ type Field[T IntLike] struct {
 	// are taken modulo this polynomial - That is to say, polynomials are divided
 	// by this polynomial and the remainder is used as the final output.
 	Prime Polynomial
+
+	// Inversion selects the algorithm used by MultInverse and Div. The zero value
+	// selects InversionEuclid.
+	Inversion InversionMethod
+
+	// Checked enables validation of every operand passed to the Field's methods.
+	// If Checked is true, passing a value which is not an element of the field
+	// causes a panic, whose value is an error wrapping ErrElementOutOfRange.
+	// Otherwise such values produce undefined results.
+	Checked bool
+
+	// Fast reduction modulo a sparse Prime, prepared by NewField.
+	sparse sparseReduction
 }
 
 // NewField creates a Field generated by the given prime polynomial.
@@ -24,22 +38,79 @@ type Field[T IntLike] struct {
 // mathematical operations.
 //
 // If a signed integer type is used for T, Field operations on negative values
-// will return undefined results.
+// will return undefined results, as will operations on values too large to be
+// elements of the field. Use Contains or a Checked Field to detect such values,
+// or Reduce to map them into the field.
+//
+// If the prime polynomial is a trinomial or pentanomial, NewField prepares the
+// Field to reduce products modulo the prime with a few shifts and additions of
+// whole words, rather than by long division.
 //
 // Panics if the type parameter T is not of sufficient size to represent every
 // element in the field.
 func NewField[T IntLike](prime Polynomial) *Field[T] {
+	if err := checkTypeSize[T](prime); err != nil {
+		panic(err.Error())
+	}
+
+	field := &Field[T]{Prime: prime}
+	if sparse, ok := newSparseReduction(prime); ok {
+		field.sparse = sparse
+	}
+	return field
+}
+
+// checkTypeSize returns an error if the type parameter T is not of sufficient
+// size to represent every element in the field generated by prime.
+func checkTypeSize[T IntLike](prime Polynomial) error {
 	maxTypeValue := getMaxTypeValue[T]()
 	if maxTypeValue < fieldOrder(prime)-1 {
-		panic(
-			fmt.Sprintf(
-				"cannot use %T to represent elements of GF(2^%d); max type value %d is too small",
-				T(0), prime.Degree(), maxTypeValue,
-			),
+		return fmt.Errorf(
+			"cannot use %T to represent elements of GF(2^%d); max type value %d is too small",
+			T(0), prime.Degree(), maxTypeValue,
 		)
 	}
+	return nil
+}
 
-	return &Field[T]{Prime: prime}
+// TryNewField creates a Field generated by the given prime polynomial, like
+// NewField, but returns an error instead of panicking. It is the same as
+// NewValidatedField with no optional checks, and returns the same errors.
+func TryNewField[T IntLike](prime Polynomial) (*Field[T], error) {
+	return NewValidatedField[T](prime, 0)
+}
+
+// Contains returns true if x is an element of the field: non-negative, and less
+// than the field's order. Field operations on values which are not elements of
+// the field return undefined results, unless the field is Checked.
+func (field *Field[T]) Contains(x T) bool {
+	return x >= 0 && uint64(x) < field.Order()
+}
+
+// Reduce maps an arbitrary integer x into the field, by treating the bits of x as
+// the coefficients of a polynomial, and returning its remainder modulo the
+// field's prime polynomial. Elements of the field are returned unchanged.
+// Negative values are treated as their 64-bit two's complement representation.
+func (field *Field[T]) Reduce(x T) T {
+	return T(field.reduce(Polynomial(uint64(x))))
+}
+
+// check panics if the field is Checked, and any of the given values is not an
+// element of the field. The panic value is an error wrapping ErrElementOutOfRange.
+func (field *Field[T]) check(values ...T) {
+	if field.Checked {
+		field.checkValues(values)
+	}
+}
+
+// checkValues implements check, which is kept small so that it can be inlined
+// when the field is not Checked.
+func (field *Field[T]) checkValues(values []T) {
+	for _, v := range values {
+		if !field.Contains(v) {
+			panic(fmt.Errorf("%w: %d is not an element of GF(2^%d)", ErrElementOutOfRange, v, field.Prime.Degree()))
+		}
+	}
 }
 
 // Order returns the order of the field (i.e. the number of elements, including zero).
@@ -54,7 +125,20 @@ func (field *Field[T]) Order() uint64 {
 // that order.
 func (field *Field[T]) Generate(exponent uint64) T {
 	exponent %= (field.Order() - 1)
-	return T(Generator.Exp(exponent, field.Prime))
+	return T(field.exp(Generator, exponent))
+}
+
+// GenerateInt constructs a field element by exponentiating the Generator element
+// to a signed exponent. A negative exponent -k produces the multiplicative inverse
+// of Generate(k).
+func (field *Field[T]) GenerateInt(exponent int64) T {
+	return T(field.exp(Generator, field.reduceExponent(exponent)))
+}
+
+// GenerateBig constructs a field element by exponentiating the Generator element
+// to an arbitrarily large exponent, which may be negative. See GenerateInt.
+func (field *Field[T]) GenerateBig(exponent *big.Int) T {
+	return T(field.exp(Generator, field.reduceBigExponent(exponent)))
 }
 
 // Add computes the sum of the given elements within the finite field.
@@ -62,6 +146,7 @@ func (field *Field[T]) Generate(exponent uint64) T {
 // Note that addition and subtraction within a Field is the same, because
 // addition consists of adding polynomials with coefficients modulo two.
 func (field *Field[T]) Add(values ...T) (sum T) {
+	field.check(values...)
 	for _, v := range values {
 		sum ^= v
 	}
@@ -73,6 +158,7 @@ func (field *Field[T]) Add(values ...T) (sum T) {
 // Note that addition and subtraction within a Field is the same, because
 // addition consists of adding polynomials with coefficients modulo two.
 func (field *Field[T]) Sub(a, b T) T {
+	field.check(a, b)
 	return a ^ b
 }
 
@@ -81,6 +167,7 @@ func (field *Field[T]) Sub(a, b T) T {
 //
 // If any element is the additive identity element 0, Mul always returns zero.
 func (field *Field[T]) Mul(values ...T) (product T) {
+	field.check(values...)
 	if len(values) == 0 {
 		return 0
 	}
@@ -91,13 +178,14 @@ func (field *Field[T]) Mul(values ...T) (product T) {
 	}
 	p := Polynomial(values[0])
 	for _, v := range values[1:] {
-		p = p.Mul(Polynomial(v)).Mod(field.Prime)
+		p = field.mulMod(p, Polynomial(v))
 	}
 	return T(p)
 }
 
 // MultInverse computes the multiplicative inverse of y within the finite field, using the
-// extended euclidean algorithm:
+// algorithm selected by the field's Inversion method. By default this is the extended
+// euclidean algorithm:
 //
 //	https://en.wikipedia.org/wiki/Extended_Euclidean_algorithm#Simple_algebraic_field_extensions
 //
@@ -109,15 +197,68 @@ func (field *Field[T]) Mul(values ...T) (product T) {
 //
 // Panics if y is the additive identity element zero.
 func (field *Field[T]) MultInverse(y T) T {
+	field.check(y)
 	if y == 0 {
 		panic("division by zero error")
 	}
 
+	inverse, ok := field.inverse(Polynomial(y))
+	if !ok {
+		panic(
+			fmt.Sprintf("failed to find inverse of GF(2^%d) element %d", field.Prime.Degree(), y),
+		)
+	}
+	return T(inverse)
+}
+
+// TryMultInverse computes the multiplicative inverse of y within the finite field,
+// like MultInverse, but returns an error instead of panicking.
+//
+// Returns an error wrapping ErrDivisionByZero if y is zero, ErrElementOutOfRange
+// if y is not an element of the field, or ErrNotIrreducible if y has no inverse
+// because the field's prime polynomial is not irreducible.
+func (field *Field[T]) TryMultInverse(y T) (T, error) {
+	if !field.Contains(y) {
+		return 0, fmt.Errorf("%w: %d is not an element of GF(2^%d)", ErrElementOutOfRange, y, field.Prime.Degree())
+	}
+	if y == 0 {
+		return 0, fmt.Errorf("%w: zero has no multiplicative inverse", ErrDivisionByZero)
+	}
+
+	// Fermat and Itoh-Tsujii inversion always produce a result, so it must be
+	// checked when the prime might not be irreducible.
+	inverse, ok := field.inverse(Polynomial(y))
+	if !ok || field.mulMod(Polynomial(y), inverse) != 1 {
+		return 0, fmt.Errorf(
+			"%w: failed to find inverse of element %d modulo %s",
+			ErrNotIrreducible, y, field.Prime,
+		)
+	}
+	return T(inverse), nil
+}
+
+// inverse computes the multiplicative inverse of the non-zero element y using the
+// field's Inversion method. Returns false if the extended euclidean algorithm
+// finds that y has no inverse.
+func (field *Field[T]) inverse(y Polynomial) (Polynomial, bool) {
+	switch field.Inversion {
+	case InversionFermat:
+		return field.inverseFermat(y), true
+	case InversionItohTsujii:
+		return field.inverseItohTsujii(y), true
+	}
+	return field.inverseEuclid(y)
+}
+
+// inverseEuclid computes the multiplicative inverse of the non-zero element y using the
+// extended euclidean algorithm. Returns false if y has no inverse, which can only
+// happen if the field's prime polynomial is not irreducible.
+func (field *Field[T]) inverseEuclid(y Polynomial) (Polynomial, bool) {
 	t := Polynomial(0)
 	r := field.Prime
 
 	newt := Polynomial(1)
-	newr := Polynomial(y)
+	newr := y
 
 	for newr != 0 {
 		quotient, remainder := r.Div(newr)
@@ -126,13 +267,11 @@ func (field *Field[T]) MultInverse(y T) T {
 	}
 
 	if r.Degree() > 0 {
-		panic(
-			fmt.Sprintf("failed to find inverse of GF(2^%d) element %d", field.Prime.Degree(), y),
-		)
+		return 0, false
 	}
 
 	// t is now the multiplicative inverse of y in the field. x*t is the same as x/y.
-	return T(t)
+	return t, true
 }
 
 // Div returns the division of the numerator field element by the denominator element.
@@ -144,19 +283,156 @@ func (field *Field[T]) MultInverse(y T) T {
 // zero, Div will panic when trying to calculate the multiplicative inverse of
 // denominator.
 func (field *Field[T]) Div(numerator, denominator T) T {
+	field.check(numerator, denominator)
 	denomInverse := Polynomial(field.MultInverse(denominator))
-	return T(Polynomial(numerator).Mul(denomInverse).Mod(field.Prime))
+	return T(field.mulMod(Polynomial(numerator), denomInverse))
+}
+
+// TryDiv returns the division of the numerator field element by the denominator
+// element, like Div, but returns an error instead of panicking.
+//
+// Returns an error wrapping ErrDivisionByZero if denominator is zero, or
+// ErrElementOutOfRange if either argument is not an element of the field. See
+// TryMultInverse.
+func (field *Field[T]) TryDiv(numerator, denominator T) (T, error) {
+	if !field.Contains(numerator) {
+		return 0, fmt.Errorf("%w: %d is not an element of GF(2^%d)", ErrElementOutOfRange, numerator, field.Prime.Degree())
+	}
+	denomInverse, err := field.TryMultInverse(denominator)
+	if err != nil {
+		return 0, err
+	}
+	return T(field.mulMod(Polynomial(numerator), Polynomial(denomInverse))), nil
 }
 
 // Exp multiplies the base element by itself the given number of times.
 //
-// If exponent is zero, returns the multiplicative identity 1.
+// If exponent is zero, returns the multiplicative identity 1, even if base is
+// zero. Otherwise, if base is zero, returns zero. Because every non-zero element
+// a satisfies a^(2^m - 1) = 1, the exponent is reduced modulo 2^m - 1.
 func (field *Field[T]) Exp(base T, exponent uint64) T {
+	field.check(base)
 	if exponent == 0 {
 		return 1
+	} else if base == 0 {
+		return 0
 	}
 
 	exponent %= (field.Order() - 1)
-	residue := Polynomial(base).Exp(exponent, field.Prime)
+	residue := field.exp(Polynomial(base), exponent)
 	return T(residue)
 }
+
+// ExpInt raises the base element to the power of a signed exponent. A negative
+// exponent -k raises the multiplicative inverse of base to the power k.
+//
+// Panics if base is zero and exponent is negative.
+func (field *Field[T]) ExpInt(base T, exponent int64) T {
+	field.check(base)
+	if exponent >= 0 {
+		return field.Exp(base, uint64(exponent))
+	} else if base == 0 {
+		panic("division by zero error; cannot raise zero to a negative power")
+	}
+
+	// a^-k = a^(N - k mod N), where N = 2^m - 1 is the order of every element's
+	// multiplicative group.
+	return field.Exp(base, field.reduceExponent(exponent))
+}
+
+// ExpBig raises the base element to the power of an arbitrarily large exponent,
+// which may be negative. See ExpInt.
+//
+// Panics if base is zero and exponent is negative.
+func (field *Field[T]) ExpBig(base T, exponent *big.Int) T {
+	field.check(base)
+	if exponent.Sign() == 0 {
+		return 1
+	} else if base == 0 {
+		if exponent.Sign() < 0 {
+			panic("division by zero error; cannot raise zero to a negative power")
+		}
+		return 0
+	}
+	return field.Exp(base, field.reduceBigExponent(exponent))
+}
+
+// reduceExponent reduces a signed exponent modulo 2^m - 1, returning a result in
+// the range [0, 2^m - 1).
+func (field *Field[T]) reduceExponent(exponent int64) uint64 {
+	groupOrder := int64(field.Order() - 1)
+	reduced := exponent % groupOrder
+	if reduced < 0 {
+		reduced += groupOrder
+	}
+	return uint64(reduced)
+}
+
+// reduceBigExponent reduces an arbitrarily large exponent modulo 2^m - 1,
+// returning a result in the range [0, 2^m - 1).
+func (field *Field[T]) reduceBigExponent(exponent *big.Int) uint64 {
+	groupOrder := new(big.Int).SetUint64(field.Order() - 1)
+	return new(big.Int).Mod(exponent, groupOrder).Uint64()
+}
+
+// Frobenius applies the Frobenius automorphism k times to the element a,
+// returning a^(2^k). The Frobenius map a -> a^2 preserves addition and
+// multiplication, and applying it m times to an element of GF(2^m) returns
+// the same element, so k is reduced modulo m.
+//
+// Panics if the field's prime polynomial has degree zero. The panic value is an
+// error wrapping ErrInvalidPrime.
+func (field *Field[T]) Frobenius(a T, k uint64) T {
+	field.check(a)
+	m := field.Prime.Degree()
+	if m == 0 {
+		panic(fmt.Errorf("%w: cannot apply Frobenius map modulo a prime polynomial of degree zero", ErrInvalidPrime))
+	}
+	k %= m
+	for i := uint64(0); i < k; i++ {
+		a = field.Mul(a, a)
+	}
+	return a
+}
+
+// Conjugates returns the distinct conjugates of the element a: a, a^2, a^4, and
+// so on, until squaring returns to a. The number of conjugates always divides
+// the degree m of the field's prime polynomial. Elements of the subfield GF(2),
+// zero and one, are their own only conjugates.
+func (field *Field[T]) Conjugates(a T) []T {
+	field.check(a)
+	conjugates := []T{a}
+	for c := field.Mul(a, a); c != a; c = field.Mul(c, c) {
+		conjugates = append(conjugates, c)
+	}
+	return conjugates
+}
+
+// Trace returns the absolute trace of the element a, which is the sum of
+// a^(2^i) for i from 0 to m-1. The trace is always zero or one, and it is
+// linear, so that Trace(a + b) = Trace(a) + Trace(b).
+//
+// Exactly half of the field's elements have a trace of zero. The equation
+// x^2 + x = c has a solution in the field if and only if Trace(c) is zero.
+func (field *Field[T]) Trace(a T) T {
+	field.check(a)
+	sum := a
+	for i := uint64(1); i < field.Prime.Degree(); i++ {
+		a = field.Mul(a, a)
+		sum = field.Add(sum, a)
+	}
+	return sum
+}
+
+// Norm returns the absolute norm of the element a, which is the product of
+// a^(2^i) for i from 0 to m-1. This is a^(2^m - 1), which is one for every
+// non-zero element, and zero for zero.
+func (field *Field[T]) Norm(a T) T {
+	field.check(a)
+	product := a
+	for i := uint64(1); i < field.Prime.Degree(); i++ {
+		a = field.Mul(a, a)
+		product = field.Mul(product, a)
+	}
+	return product
+}
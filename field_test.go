@@ -1,6 +1,10 @@
 package galois
 
-import "testing"
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
 
 func TestField_OverflowSafeConstructor(t *testing.T) {
 	defer func() {
@@ -383,3 +387,338 @@ func BenchmarkField_MultInverse_32(b *testing.B) {
 		field.MultInverse(0b10101010101010101010101010101010)
 	}
 }
+func BenchmarkField_MultInverse_Fermat_8(b *testing.B) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+	field.Inversion = InversionFermat
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		field.MultInverse(0b10101010)
+	}
+}
+func BenchmarkField_MultInverse_Fermat_16(b *testing.B) {
+	field := NewField[uint16](PrimePolynomialDegree16)
+	field.Inversion = InversionFermat
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		field.MultInverse(0b1010101010101010)
+	}
+}
+func BenchmarkField_MultInverse_Fermat_24(b *testing.B) {
+	field := NewField[uint32](PrimePolynomialDegree24)
+	field.Inversion = InversionFermat
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		field.MultInverse(0b101010101010101010101010)
+	}
+}
+func BenchmarkField_MultInverse_Fermat_32(b *testing.B) {
+	field := NewField[uint32](PrimePolynomialDegree32)
+	field.Inversion = InversionFermat
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		field.MultInverse(0b10101010101010101010101010101010)
+	}
+}
+func BenchmarkField_MultInverse_ItohTsujii_8(b *testing.B) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+	field.Inversion = InversionItohTsujii
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		field.MultInverse(0b10101010)
+	}
+}
+func BenchmarkField_MultInverse_ItohTsujii_16(b *testing.B) {
+	field := NewField[uint16](PrimePolynomialDegree16)
+	field.Inversion = InversionItohTsujii
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		field.MultInverse(0b1010101010101010)
+	}
+}
+func BenchmarkField_MultInverse_ItohTsujii_24(b *testing.B) {
+	field := NewField[uint32](PrimePolynomialDegree24)
+	field.Inversion = InversionItohTsujii
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		field.MultInverse(0b101010101010101010101010)
+	}
+}
+func BenchmarkField_MultInverse_ItohTsujii_32(b *testing.B) {
+	field := NewField[uint32](PrimePolynomialDegree32)
+	field.Inversion = InversionItohTsujii
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		field.MultInverse(0b10101010101010101010101010101010)
+	}
+}
+
+func TestField_Trace(t *testing.T) {
+	primes := []Polynomial{
+		PrimePolynomialDegree2,
+		PrimePolynomialDegree3,
+		PrimePolynomialDegree4,
+		PrimePolynomialDegree5,
+		PrimePolynomialDegree8,
+		PrimePolynomialDegree10,
+	}
+
+	for _, prime := range primes {
+		field := NewField[uint16](prime)
+
+		traceOne := uint64(0)
+		for a := uint16(0); uint64(a) < field.Order(); a++ {
+			trace := field.Trace(a)
+			if trace > 1 {
+				t.Fatalf("expected trace of %d in GF(2^%d) to be 0 or 1, got %d", a, prime.Degree(), trace)
+			}
+			traceOne += uint64(trace)
+
+			// The trace is linear.
+			b := uint16(Generator.Exp(uint64(a)*7, prime).Mod(prime))
+			if field.Trace(field.Add(a, b)) != field.Add(trace, field.Trace(b)) {
+				t.Errorf("expected Tr(%d + %d) = Tr(%d) + Tr(%d)", a, b, a, b)
+			}
+
+			// The trace is invariant under the Frobenius map.
+			if field.Trace(field.Frobenius(a, 1)) != trace {
+				t.Errorf("expected Tr(%d^2) = Tr(%d)", a, a)
+			}
+		}
+
+		if traceOne != field.Order()/2 {
+			t.Errorf("expected half of GF(2^%d) to have trace one; got %d", prime.Degree(), traceOne)
+		}
+	}
+}
+
+func TestField_Norm(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+
+	if norm := field.Norm(0); norm != 0 {
+		t.Errorf("expected norm of zero to be zero, got %d", norm)
+	}
+	for a := 1; a < 256; a++ {
+		if norm := field.Norm(uint8(a)); norm != 1 {
+			t.Errorf("expected norm of %d to be one, got %d", a, norm)
+		}
+	}
+}
+
+func TestField_Frobenius(t *testing.T) {
+	field := NewField[uint16](PrimePolynomialDegree12)
+
+	for _, a := range []uint16{0, 1, 2, 0x123, 0xABC, 0xFFF} {
+		for k := uint64(0); k < 30; k++ {
+			expected := field.Exp(a, uint64(1)<<(k%12))
+			if actual := field.Frobenius(a, k); actual != expected {
+				t.Errorf("expected %d^(2^%d) = %d, got %d", a, k, expected, actual)
+			}
+		}
+	}
+}
+
+func TestField_Frobenius_DegreeZero(t *testing.T) {
+	defer func() {
+		if err, ok := recover().(error); !ok || !errors.Is(err, ErrInvalidPrime) {
+			t.Errorf("expected panic wrapping ErrInvalidPrime, got %v", err)
+		}
+	}()
+	field := &Field[uint8]{Prime: 1}
+	field.Frobenius(1, 3)
+}
+
+func TestField_Conjugates(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+
+	type TestCase struct {
+		Element uint8
+		Count   int
+	}
+
+	testCases := []TestCase{
+		{Element: 0, Count: 1},
+		{Element: 1, Count: 1},
+		{Element: field.Generate(1), Count: 8},
+
+		// Elements of the subfields GF(4) and GF(16).
+		{Element: field.Generate(85), Count: 2},
+		{Element: field.Generate(17), Count: 4},
+	}
+
+	for _, test := range testCases {
+		conjugates := field.Conjugates(test.Element)
+		if len(conjugates) != test.Count {
+			t.Errorf("expected %d to have %d conjugates, got %v", test.Element, test.Count, conjugates)
+		}
+
+		for i, c := range conjugates {
+			if expected := field.Frobenius(test.Element, uint64(i)); c != expected {
+				t.Errorf("expected conjugate %d of %d to be %d, got %d", i, test.Element, expected, c)
+			}
+		}
+	}
+}
+
+func TestField_Contains(t *testing.T) {
+	field := NewField[int16](PrimePolynomialDegree12)
+
+	for _, x := range []int16{0, 1, 0x800, 0xFFF} {
+		if !field.Contains(x) {
+			t.Errorf("expected %d to be an element of GF(2^12)", x)
+		}
+	}
+	for _, x := range []int16{-1, -0x8000, 0x1000, 0x7FFF} {
+		if field.Contains(x) {
+			t.Errorf("expected %d not to be an element of GF(2^12)", x)
+		}
+	}
+}
+
+func TestField_Reduce(t *testing.T) {
+	field := NewField[uint16](PrimePolynomialDegree12)
+	generic := &Field[uint16]{Prime: PrimePolynomialDegree12}
+
+	for x := 0; x < 0x10000; x++ {
+		reduced := field.Reduce(uint16(x))
+		if !field.Contains(reduced) {
+			t.Fatalf("expected Reduce(%d) to be an element of the field, got %d", x, reduced)
+		}
+		if reduced != uint16(Polynomial(x).Mod(PrimePolynomialDegree12)) || reduced != generic.Reduce(uint16(x)) {
+			t.Fatalf("expected Reduce(%d) to be its remainder modulo the prime", x)
+		}
+		if x < 0x1000 && reduced != uint16(x) {
+			t.Fatalf("expected Reduce to leave element %d unchanged", x)
+		}
+	}
+
+	signed := NewField[int8](PrimePolynomialDegree4)
+	if reduced := signed.Reduce(-1); reduced != int8(Polynomial(0xFFFFFFFFFFFFFFFF).Mod(PrimePolynomialDegree4)) {
+		t.Errorf("expected Reduce(-1) to reduce its two's complement representation, got %d", reduced)
+	}
+}
+
+func TestField_Checked(t *testing.T) {
+	field := NewField[uint16](PrimePolynomialDegree12)
+	field.Checked = true
+
+	// Valid operands are unaffected.
+	if field.Mul(0x123, 0x456) != NewField[uint16](PrimePolynomialDegree12).Mul(0x123, 0x456) {
+		t.Errorf("expected checked field to compute the same products")
+	}
+
+	operations := map[string]func(){
+		"Add":         func() { field.Add(1, 2, 0x1000) },
+		"Sub":         func() { field.Sub(0x1000, 1) },
+		"Mul":         func() { field.Mul(0xFFFF, 2) },
+		"MultInverse": func() { field.MultInverse(0x1000) },
+		"Div":         func() { field.Div(1, 0x2000) },
+		"Exp":         func() { field.Exp(0x1000, 3) },
+		"Trace":       func() { field.Trace(0x1000) },
+		"Sqrt":        func() { field.Sqrt(0x1000) },
+		"Log":         func() { field.Log(0x1000) },
+	}
+
+	for name, operation := range operations {
+		func() {
+			defer func() {
+				err, ok := recover().(error)
+				if !ok || !errors.Is(err, ErrElementOutOfRange) {
+					t.Errorf("expected %s to panic with ErrElementOutOfRange, got %v", name, err)
+				}
+			}()
+			operation()
+		}()
+	}
+}
+
+func TestField_Exp_ZeroBase(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+
+	if field.Exp(0, 0) != 1 {
+		t.Errorf("expected 0^0 = 1")
+	}
+	for _, exponent := range []uint64{1, 2, 254, 255, 256, 510, 1 << 63} {
+		if result := field.Exp(0, exponent); result != 0 {
+			t.Errorf("expected 0^%d = 0, got %d", exponent, result)
+		}
+	}
+	if field.Exp(3, 255) != 1 || field.Exp(3, 510) != 1 {
+		t.Errorf("expected a^255 = 1 for non-zero a")
+	}
+}
+
+func TestField_ExpInt(t *testing.T) {
+	field := NewField[uint16](PrimePolynomialDegree12)
+
+	for _, a := range []uint16{1, 2, 0x123, 0xFFF} {
+		inverse := field.MultInverse(a)
+		for _, k := range []int64{0, 1, 2, 7, 4095, 4096, 100000} {
+			if field.ExpInt(a, k) != field.Exp(a, uint64(k)) {
+				t.Errorf("expected ExpInt(%d, %d) to match Exp", a, k)
+			}
+			if result := field.ExpInt(a, -k); result != field.Exp(inverse, uint64(k)) {
+				t.Errorf("expected %d^-%d = %d, got %d", a, k, field.Exp(inverse, uint64(k)), result)
+			}
+		}
+
+		// The most negative exponent cannot be negated.
+		minInt := int64(-1 << 63)
+		expected := field.Exp(inverse, uint64(1<<63)%4095)
+		if result := field.ExpInt(a, minInt); result != expected {
+			t.Errorf("expected %d^%d = %d, got %d", a, minInt, expected, result)
+		}
+	}
+
+	if field.ExpInt(0, 0) != 1 || field.ExpInt(0, 5) != 0 {
+		t.Errorf("expected 0^0 = 1 and 0^5 = 0")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected to panic when raising zero to a negative power")
+		}
+	}()
+	field.ExpInt(0, -1)
+}
+
+func TestField_ExpBig(t *testing.T) {
+	field := NewField[uint32](PrimePolynomialDegree32)
+	a := uint32(0xDEADBEEF)
+
+	// (2^32 - 1) * 2^100 + 5 is congruent to 5 modulo the group order.
+	huge := new(big.Int).Lsh(big.NewInt(0xFFFFFFFF), 100)
+	huge.Add(huge, big.NewInt(5))
+	if result := field.ExpBig(a, huge); result != field.Exp(a, 5) {
+		t.Errorf("expected huge exponent to reduce to 5, got %d", result)
+	}
+	if result := field.ExpBig(a, new(big.Int).Neg(huge)); result != field.ExpInt(a, -5) {
+		t.Errorf("expected negative huge exponent to reduce to -5, got %d", result)
+	}
+	if field.ExpBig(a, big.NewInt(0)) != 1 || field.ExpBig(0, huge) != 0 || field.ExpBig(0, big.NewInt(0)) != 1 {
+		t.Errorf("expected ExpBig to handle zero base and exponent")
+	}
+	if field.GenerateBig(huge) != field.Generate(5) || field.GenerateBig(new(big.Int).Neg(huge)) != field.GenerateInt(-5) {
+		t.Errorf("expected GenerateBig to reduce its exponent")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected to panic when raising zero to a negative power")
+		}
+	}()
+	field.ExpBig(0, big.NewInt(-1))
+}
+
+func TestField_GenerateInt(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+
+	for k := int64(-600); k <= 600; k++ {
+		result := field.GenerateInt(k)
+		if k >= 0 && result != field.Generate(uint64(k)) {
+			t.Errorf("expected GenerateInt(%d) to match Generate", k)
+		}
+		if field.Mul(result, field.GenerateInt(-k)) != 1 {
+			t.Errorf("expected GenerateInt(%d) * GenerateInt(%d) = 1", k, -k)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package galois
+
+// FieldPolynomial is a polynomial whose coefficients are elements of a Field.
+// Coefficients are stored in ascending order of degree, so that p[i] is the
+// coefficient of x^i.
+//
+// Unlike Polynomial, a FieldPolynomial carries no knowledge of the Field its
+// coefficients belong to. Arithmetic on a FieldPolynomial is performed through
+// methods of that Field.
+type FieldPolynomial[T IntLike] []T
+
+// Degree returns the degree of the highest non-zero term of the FieldPolynomial.
+func (p FieldPolynomial[T]) Degree() uint64 {
+	for i := len(p) - 1; i > 0; i-- {
+		if p[i] != 0 {
+			return uint64(i)
+		}
+	}
+	return 0
+}
+
+// EvalPolynomial evaluates the polynomial p at the field element x, using
+// Horner's method.
+func (field *Field[T]) EvalPolynomial(p FieldPolynomial[T], x T) (result T) {
+	field.check(p...)
+	field.check(x)
+	for i := len(p) - 1; i >= 0; i-- {
+		result = field.Add(field.Mul(result, x), p[i])
+	}
+	return
+}
+
+// binaryFieldPolynomial converts the binary Polynomial p to a FieldPolynomial,
+// whose coefficients are the field elements zero and one.
+func binaryFieldPolynomial[T IntLike](p Polynomial) FieldPolynomial[T] {
+	coeffs := make(FieldPolynomial[T], p.Degree()+1)
+	for i := range coeffs {
+		coeffs[i] = T(p>>i) & 1
+	}
+	return coeffs
+}
+
+// trim returns p without any zero coefficients of degree higher than its
+// leading term.
+func (p FieldPolynomial[T]) trim() FieldPolynomial[T] {
+	n := len(p)
+	for n > 0 && p[n-1] == 0 {
+		n--
+	}
+	return p[:n]
+}
+
+// mulPolynomials returns the product of the polynomials a and b.
+func (field *Field[T]) mulPolynomials(a, b FieldPolynomial[T]) FieldPolynomial[T] {
+	a, b = a.trim(), b.trim()
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+
+	product := make(FieldPolynomial[T], len(a)+len(b)-1)
+	for i, ai := range a {
+		if ai == 0 {
+			continue
+		}
+		for j, bj := range b {
+			product[i+j] = field.Add(product[i+j], field.Mul(ai, bj))
+		}
+	}
+	return product
+}
+
+// divPolynomials divides the polynomial a by the polynomial b, and returns the
+// quotient and remainder.
+//
+// Panics if b is zero.
+func (field *Field[T]) divPolynomials(a, b FieldPolynomial[T]) (quotient, remainder FieldPolynomial[T]) {
+	b = b.trim()
+	if len(b) == 0 {
+		panic("divide by zero error; cannot divide polynomial by zero")
+	}
+
+	remainder = append(FieldPolynomial[T](nil), a.trim()...)
+	if len(remainder) < len(b) {
+		return nil, remainder
+	}
+
+	leadInverse := field.MultInverse(b[len(b)-1])
+	quotient = make(FieldPolynomial[T], len(remainder)-len(b)+1)
+	for shift := len(quotient) - 1; shift >= 0; shift-- {
+		coeff := field.Mul(remainder[shift+len(b)-1], leadInverse)
+		quotient[shift] = coeff
+		if coeff == 0 {
+			continue
+		}
+		for i, bi := range b {
+			remainder[shift+i] = field.Sub(remainder[shift+i], field.Mul(coeff, bi))
+		}
+	}
+	return quotient.trim(), remainder.trim()
+}
+
+// modPolynomials returns the remainder of dividing the polynomial a by the
+// polynomial b.
+func (field *Field[T]) modPolynomials(a, b FieldPolynomial[T]) FieldPolynomial[T] {
+	_, remainder := field.divPolynomials(a, b)
+	return remainder
+}
+
+// gcdPolynomials returns the monic greatest common divisor of the polynomials a
+// and b, using the euclidean algorithm.
+func (field *Field[T]) gcdPolynomials(a, b FieldPolynomial[T]) FieldPolynomial[T] {
+	a, b = a.trim(), b.trim()
+	for len(b) > 0 {
+		a, b = b, field.modPolynomials(a, b)
+	}
+	if len(a) == 0 {
+		return nil
+	}
+
+	leadInverse := field.MultInverse(a[len(a)-1])
+	monic := make(FieldPolynomial[T], len(a))
+	for i, coeff := range a {
+		monic[i] = field.Mul(coeff, leadInverse)
+	}
+	return monic
+}
+
+// polynomialRoot returns a root of the polynomial f, which must be a product of
+// distinct linear factors in the field, using the trace-splitting method of
+// Berlekamp: for an element d, the polynomial Tr(d*y) mod f separates the roots of
+// f whose product with d has trace zero from those with trace one. As d ranges over
+// the powers of x, which form a basis of the field, any two roots are eventually
+// separated.
+//
+// Returns false if no root is found, which happens if f is not a product of
+// distinct linear factors.
+func (field *Field[T]) polynomialRoot(f FieldPolynomial[T]) (T, bool) {
+	f = f.trim()
+	for len(f) > 2 {
+		g, ok := field.splitPolynomial(f)
+		if !ok {
+			return 0, false
+		}
+		f = g
+	}
+
+	if len(f) != 2 {
+		return 0, false
+	}
+	return field.Div(f[0], f[1]), true
+}
+
+// splitPolynomial returns a non-trivial factor of f, which must be a product of
+// distinct linear factors. Returns false if no such factor is found.
+func (field *Field[T]) splitPolynomial(f FieldPolynomial[T]) (FieldPolynomial[T], bool) {
+	m := field.Prime.Degree()
+	for d := uint64(0); d < m; d++ {
+		// Compute the trace of d*y modulo f, where y is the polynomial variable.
+		term := field.modPolynomials(FieldPolynomial[T]{0, field.Generate(d)}, f)
+		trace := term
+		for i := uint64(1); i < m; i++ {
+			term = field.modPolynomials(field.mulPolynomials(term, term), f)
+			trace = field.addPolynomials(trace, term)
+		}
+
+		g := field.gcdPolynomials(f, trace)
+		if len(g) > 1 && len(g) < len(f) {
+			return g, true
+		}
+	}
+	return nil, false
+}
+
+// addPolynomials returns the sum of the polynomials a and b.
+func (field *Field[T]) addPolynomials(a, b FieldPolynomial[T]) FieldPolynomial[T] {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	sum := append(FieldPolynomial[T](nil), a...)
+	for i, coeff := range b {
+		sum[i] = field.Add(sum[i], coeff)
+	}
+	return sum.trim()
+}
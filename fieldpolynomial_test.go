@@ -0,0 +1,72 @@
+package galois
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestField_DivPolynomials(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	field := NewField[uint8](PrimePolynomialDegree8)
+
+	randomPolynomial := func(length int) FieldPolynomial[uint8] {
+		p := make(FieldPolynomial[uint8], length)
+		for i := range p {
+			p[i] = uint8(rng.Intn(256))
+		}
+		return p
+	}
+
+	for trial := 0; trial < 200; trial++ {
+		a := randomPolynomial(1 + rng.Intn(12))
+		b := randomPolynomial(1 + rng.Intn(6))
+		if len(b.trim()) == 0 {
+			continue
+		}
+
+		quotient, remainder := field.divPolynomials(a, b)
+		if len(remainder) >= len(b.trim()) {
+			t.Errorf("remainder %v is not smaller than divisor %v", remainder, b)
+		}
+
+		recombined := field.addPolynomials(field.mulPolynomials(quotient, b), remainder)
+		if !reflect.DeepEqual(recombined, a.trim()) {
+			t.Errorf("expected %v * %v + %v = %v, got %v", quotient, b, remainder, a, recombined)
+		}
+	}
+}
+
+func TestField_GCDPolynomials(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+
+	// (y + 3)(y + 5) and (y + 3)(y + 7) share the factor y + 3.
+	a := field.mulPolynomials(FieldPolynomial[uint8]{3, 1}, FieldPolynomial[uint8]{5, 1})
+	b := field.mulPolynomials(FieldPolynomial[uint8]{3, 1}, FieldPolynomial[uint8]{7, 1})
+
+	gcd := field.gcdPolynomials(field.mulPolynomials(a, FieldPolynomial[uint8]{9}), b)
+	if !reflect.DeepEqual(gcd, FieldPolynomial[uint8]{3, 1}) {
+		t.Errorf("expected monic GCD y + 3, got %v", gcd)
+	}
+}
+
+func TestField_PolynomialRoot(t *testing.T) {
+	field := NewField[uint16](PrimePolynomialDegree12)
+
+	roots := []uint16{0, 1, 0x123, 0xABC, 0xFFF, 0x800}
+	f := FieldPolynomial[uint16]{1}
+	for _, r := range roots {
+		f = field.mulPolynomials(f, FieldPolynomial[uint16]{r, 1})
+	}
+
+	root, ok := field.polynomialRoot(f)
+	if !ok || field.EvalPolynomial(f, root) != 0 {
+		t.Errorf("failed to find root of polynomial; got %d (%v)", root, ok)
+	}
+
+	// The binary polynomial x^2 + x + 1 is irreducible over GF(2^5).
+	oddField := NewField[uint8](PrimePolynomialDegree5)
+	if _, ok := oddField.polynomialRoot(FieldPolynomial[uint8]{1, 1, 1}); ok {
+		t.Errorf("expected no root of x^2 + x + 1 in GF(2^5)")
+	}
+}
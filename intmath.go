@@ -0,0 +1,46 @@
+package galois
+
+// primeFactors returns the distinct prime factors of n in ascending order,
+// using trial division.
+func primeFactors(n uint64) []uint64 {
+	var factors []uint64
+	for q := uint64(2); q*q <= n; q++ {
+		if n%q == 0 {
+			factors = append(factors, q)
+			for n%q == 0 {
+				n /= q
+			}
+		}
+	}
+	if n > 1 {
+		factors = append(factors, n)
+	}
+	return factors
+}
+
+// gcd returns the greatest common divisor of a and b.
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// bezout returns the greatest common divisor d of a and n, along with a
+// coefficient s in the range [0, n) such that s*a = d mod n, using the extended
+// euclidean algorithm. n must be less than 2^63.
+func bezout(a, n uint64) (d, s uint64) {
+	oldR, r := int64(a%n), int64(n)
+	oldS, newS := int64(1), int64(0)
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldS, newS = newS, oldS-q*newS
+	}
+
+	oldS %= int64(n)
+	if oldS < 0 {
+		oldS += int64(n)
+	}
+	return uint64(oldR), uint64(oldS)
+}
@@ -0,0 +1,45 @@
+package galois
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPrimeFactors(t *testing.T) {
+	type TestCase struct {
+		N       uint64
+		Factors []uint64
+	}
+
+	testCases := []TestCase{
+		{N: 1, Factors: nil},
+		{N: 2, Factors: []uint64{2}},
+		{N: 12, Factors: []uint64{2, 3}},
+		{N: 255, Factors: []uint64{3, 5, 17}},
+		{N: 1<<31 - 1, Factors: []uint64{1<<31 - 1}},
+		{N: 1<<32 - 1, Factors: []uint64{3, 5, 17, 257, 65537}},
+	}
+
+	for _, test := range testCases {
+		if factors := primeFactors(test.N); !reflect.DeepEqual(factors, test.Factors) {
+			t.Errorf("expected prime factors of %d to be %v (got %v)", test.N, test.Factors, factors)
+		}
+	}
+}
+
+func TestBezout(t *testing.T) {
+	for _, n := range []uint64{1, 15, 63, 255, 4095, 1<<31 - 1, 1<<32 - 1} {
+		for _, a := range []uint64{0, 1, 2, 3, 9, 21, 100, 65537, 1 << 40} {
+			d, s := bezout(a, n)
+			if d != gcd(a, n) && !(a%n == 0 && d == n) {
+				t.Errorf("expected gcd(%d, %d) = %d, got %d", a, n, gcd(a, n), d)
+			}
+			if s >= n && n > 1 {
+				t.Errorf("bezout coefficient %d for (%d, %d) out of range", s, a, n)
+			}
+			if n > 1 && (s*(a%n))%n != d%n {
+				t.Errorf("expected %d * %d = %d mod %d", s, a, d, n)
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package galois
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// BatchInverse computes the multiplicative inverse of every element of in, and
+// stores the inverses in out, using Montgomery's trick: it computes the running
+// products of the elements, inverts only the final product, and then recovers
+// each inverse with two more multiplications. This takes one inversion and
+// 3n multiplications, one per element to build the running products and two
+// per element to recover the inverses, rather than n inversions.
+//
+// If skipZeros is true, zero elements of in, which have no inverse, are ignored,
+// and the corresponding elements of out are set to zero. Otherwise, BatchInverse
+// returns an error wrapping ErrDivisionByZero if any element of in is zero, and
+// out is left unmodified.
+//
+// in and out may be the same slice.
+//
+// Panics if in and out have different lengths.
+func (field *Field[T]) BatchInverse(in, out []T, skipZeros bool) error {
+	field.check(in...)
+	if len(in) != len(out) {
+		panic(fmt.Sprintf("cannot store %d inverses in slice of length %d", len(in), len(out)))
+	}
+
+	// prefix[i] is the product of every non-zero element of in[:i+1].
+	prefix := make([]Polynomial, len(in))
+	product := Polynomial(1)
+	for i, v := range in {
+		if v == 0 {
+			if !skipZeros {
+				return fmt.Errorf("%w: cannot invert zero element at index %d", ErrDivisionByZero, i)
+			}
+		} else {
+			product = field.mulMod(product, Polynomial(v))
+		}
+		prefix[i] = product
+	}
+	if len(in) == 0 {
+		return nil
+	}
+
+	// inverse is the inverse of prefix[i], as i descends.
+	inverse := Polynomial(field.MultInverse(T(product)))
+	for i := len(in) - 1; i >= 0; i-- {
+		v := Polynomial(in[i])
+		if v == 0 {
+			out[i] = 0
+			continue
+		}
+
+		previous := Polynomial(1)
+		if i > 0 {
+			previous = prefix[i-1]
+		}
+		out[i] = T(field.mulMod(inverse, previous))
+		inverse = field.mulMod(inverse, v)
+	}
+	return nil
+}
+
+// InversionMethod selects the algorithm a Field uses to compute multiplicative
+// inverses.
+type InversionMethod int
+
+const (
+	// InversionEuclid computes inverses with the extended euclidean algorithm.
+	// It is usually the fastest method, but the number of steps it takes depends
+	// on the element being inverted.
+	InversionEuclid InversionMethod = iota
+
+	// InversionFermat computes the inverse of a as a^(2^m - 2), by Fermat's
+	// little theorem, using square and multiply. It performs the same sequence
+	// of squarings and multiplications for every element.
+	InversionFermat
+
+	// InversionItohTsujii computes the inverse of a as (a^(2^(m-1) - 1))^2, using
+	// the Itoh-Tsujii addition chain, which needs only about log2(m)
+	// multiplications and m squarings. Squaring is cheap in fields of
+	// characteristic two, so this is usually faster than InversionFermat.
+	InversionItohTsujii
+)
+
+// String returns the name of the inversion method.
+func (method InversionMethod) String() string {
+	switch method {
+	case InversionEuclid:
+		return "Euclid"
+	case InversionFermat:
+		return "Fermat"
+	case InversionItohTsujii:
+		return "ItohTsujii"
+	}
+	return fmt.Sprintf("InversionMethod(%d)", int(method))
+}
+
+// inverseFermat computes the multiplicative inverse of the non-zero element y
+// as y^(2^m - 2).
+func (field *Field[T]) inverseFermat(y Polynomial) Polynomial {
+	return field.exp(y, field.Order()-2)
+}
+
+// inverseItohTsujii computes the multiplicative inverse of the non-zero element
+// y. It builds b_k = y^(2^k - 1) for k = m - 1 using the identities:
+//
+//	b_(2k)  = (b_k)^(2^k) * b_k
+//	b_(k+1) = (b_k)^2 * y
+//
+// following the binary expansion of m - 1, and then returns (b_(m-1))^2.
+func (field *Field[T]) inverseItohTsujii(y Polynomial) Polynomial {
+	n := field.Prime.Degree() - 1
+	if n == 0 {
+		return y
+	}
+
+	b := y
+	k := uint64(1)
+	for i := bits.Len64(n) - 2; i >= 0; i-- {
+		b = field.mulMod(field.squareTimes(b, k), b)
+		k *= 2
+		if (n>>i)&1 == 1 {
+			b = field.mulMod(field.squareTimes(b, 1), y)
+			k++
+		}
+	}
+	return field.squareTimes(b, 1)
+}
+
+// squareTimes squares the element a repeatedly, n times, returning a^(2^n).
+func (field *Field[T]) squareTimes(a Polynomial, n uint64) Polynomial {
+	for i := uint64(0); i < n; i++ {
+		a = field.squareMod(a)
+	}
+	return a
+}
@@ -0,0 +1,146 @@
+package galois
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestField_BatchInverse(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	field := NewField[uint16](PrimePolynomialDegree16)
+
+	for _, n := range []int{0, 1, 2, 17, 500} {
+		in := make([]uint16, n)
+		for i := range in {
+			in[i] = uint16(1 + rng.Intn(0xFFFF))
+		}
+
+		out := make([]uint16, n)
+		if err := field.BatchInverse(in, out, false); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		for i, v := range in {
+			if out[i] != field.MultInverse(v) {
+				t.Errorf("expected inverse of %d to be %d, got %d", v, field.MultInverse(v), out[i])
+			}
+		}
+
+		// Inverting in place.
+		original := append([]uint16(nil), in...)
+		if err := field.BatchInverse(in, in, false); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		for i, v := range original {
+			if in[i] != field.MultInverse(v) {
+				t.Errorf("expected in-place inverse of %d to be %d, got %d", v, field.MultInverse(v), in[i])
+			}
+		}
+	}
+}
+
+func TestField_BatchInverse_Zeros(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+
+	in := []uint8{0, 3, 0, 0x53, 1, 0}
+	out := []uint8{9, 9, 9, 9, 9, 9}
+
+	if err := field.BatchInverse(in, out, false); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("expected ErrDivisionByZero when inverting zero, got %v", err)
+	}
+	for _, v := range out {
+		if v != 9 {
+			t.Errorf("expected output to be unmodified after error, got %v", out)
+			break
+		}
+	}
+
+	if err := field.BatchInverse(in, out, true); err != nil {
+		t.Fatalf("unexpected error when skipping zeros: %s", err)
+	}
+	for i, v := range in {
+		expected := uint8(0)
+		if v != 0 {
+			expected = field.MultInverse(v)
+		}
+		if out[i] != expected {
+			t.Errorf("expected inverse of %d to be %d, got %d", v, expected, out[i])
+		}
+	}
+
+	// A batch of only zeros inverts to zeros.
+	zeros := []uint8{0, 0}
+	if err := field.BatchInverse(zeros, zeros, true); err != nil || zeros[0] != 0 || zeros[1] != 0 {
+		t.Errorf("expected batch of zeros to invert to zeros, got %v (%v)", zeros, err)
+	}
+}
+
+func BenchmarkField_BatchInverse_16(b *testing.B) {
+	field := NewField[uint16](PrimePolynomialDegree16)
+	in := make([]uint16, 256)
+	for i := range in {
+		in[i] = uint16(i*257 + 1)
+	}
+	out := make([]uint16, len(in))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		field.BatchInverse(in, out, false)
+	}
+}
+
+func TestField_InversionMethods(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	methods := []InversionMethod{InversionEuclid, InversionFermat, InversionItohTsujii}
+
+	primes := []Polynomial{
+		PrimePolynomialDegree2,
+		PrimePolynomialDegree3,
+		PrimePolynomialDegree4,
+		PrimePolynomialDegree7,
+		PrimePolynomialDegree8,
+		PrimePolynomialDegree13,
+		PrimePolynomialDegree16,
+		PrimePolynomialDegree17,
+		PrimePolynomialDegree24,
+		PrimePolynomialDegree31,
+		PrimePolynomialDegree32,
+	}
+
+	for _, prime := range primes {
+		degree := prime.Degree()
+		field := NewField[uint32](prime)
+		mask := uint32(field.Order() - 1)
+
+		for trial := 0; trial < 100; trial++ {
+			a := rng.Uint32() & mask
+			if a == 0 {
+				a = 1
+			}
+			for _, method := range methods {
+				field.Inversion = method
+				inverse := field.MultInverse(a)
+				if product := field.Mul(a, inverse); product != 1 {
+					t.Fatalf("%s inverse of %d in GF(2^%d) is incorrect; product is %d", method, a, degree, product)
+				}
+			}
+		}
+	}
+}
+
+func TestField_InversionMethods_Exhaustive(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+	for a := 1; a < 256; a++ {
+		expected := field.MultInverse(uint8(a))
+		for _, method := range []InversionMethod{InversionFermat, InversionItohTsujii} {
+			field.Inversion = method
+			if inverse := field.MultInverse(uint8(a)); inverse != expected {
+				t.Errorf("expected %s inverse of %d to be %d, got %d", method, a, expected, inverse)
+			}
+			if quotient := field.Div(1, uint8(a)); quotient != expected {
+				t.Errorf("expected %s quotient 1/%d to be %d, got %d", method, a, expected, quotient)
+			}
+		}
+		field.Inversion = InversionEuclid
+	}
+}
@@ -0,0 +1,77 @@
+package galois
+
+import "fmt"
+
+// FieldIsomorphism translates elements between two Fields of the same order
+// whose prime polynomials differ, such as the AES field over 0x11B and the
+// Reed-Solomon field over 0x11D. Although the two Fields assign different
+// integers to their elements, they are structurally identical, and Map
+// preserves both addition and multiplication:
+//
+//	Map(src.Mul(a, b)) == dst.Mul(Map(a), Map(b))
+//
+// The isomorphism sends the Generator x of the source Field to a root r of the
+// source prime polynomial in the destination Field, and so maps the element
+// with coefficients a_i to the sum of a_i * r^i. This is a linear map over
+// GF(2), represented as a change-of-basis matrix.
+type FieldIsomorphism[T IntLike] struct {
+	Src *Field[T]
+	Dst *Field[T]
+
+	forward bitMatrix
+	inverse bitMatrix
+}
+
+// Isomorphism returns an isomorphism which maps elements of the src Field to
+// elements of the dst Field. The root of src.Prime used to construct the map is
+// found by the trace-splitting method, so the isomorphism is deterministic, but
+// generally one of several: there is one for each of the m roots of src.Prime.
+//
+// Panics if the fields have prime polynomials of different degrees, or if
+// src.Prime has no root in dst, which can only happen if either prime
+// polynomial is not irreducible.
+func Isomorphism[T IntLike](src, dst *Field[T]) *FieldIsomorphism[T] {
+	m := src.Prime.Degree()
+	if dst.Prime.Degree() != m {
+		panic(
+			fmt.Sprintf(
+				"cannot map GF(2^%d) to GF(2^%d); fields must have the same order",
+				m, dst.Prime.Degree(),
+			),
+		)
+	}
+
+	root, ok := dst.polynomialRoot(binaryFieldPolynomial[T](src.Prime))
+	if !ok {
+		panic(fmt.Sprintf("failed to find root of %s in field over %s", src.Prime, dst.Prime))
+	}
+
+	forward := make(bitMatrix, m)
+	power := T(1)
+	for i := range forward {
+		forward[i] = uint64(power)
+		power = dst.Mul(power, root)
+	}
+	inverse, ok := forward.inverse()
+	if !ok {
+		panic(fmt.Sprintf("powers of %d do not form a basis of field over %s", root, dst.Prime))
+	}
+
+	return &FieldIsomorphism[T]{
+		Src:     src,
+		Dst:     dst,
+		forward: forward,
+		inverse: inverse,
+	}
+}
+
+// Map translates the element a of the Src field into the Dst field.
+func (iso *FieldIsomorphism[T]) Map(a T) T {
+	return T(iso.forward.mulVec(uint64(a)))
+}
+
+// Inverse translates the element b of the Dst field back into the Src field.
+// It is the inverse of Map.
+func (iso *FieldIsomorphism[T]) Inverse(b T) T {
+	return T(iso.inverse.mulVec(uint64(b)))
+}
@@ -0,0 +1,69 @@
+package galois
+
+import (
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+func TestIsomorphism_AES(t *testing.T) {
+	aes := NewField[uint8](0x11B)
+	rs := NewField[uint8](0x11D)
+	iso := Isomorphism(aes, rs)
+
+	seen := make(map[uint8]bool)
+	for a := 0; a < 256; a++ {
+		mapped := iso.Map(uint8(a))
+		if seen[mapped] {
+			t.Fatalf("isomorphism is not one-to-one; %d maps to %d twice", a, mapped)
+		}
+		seen[mapped] = true
+
+		if back := iso.Inverse(mapped); back != uint8(a) {
+			t.Errorf("expected Inverse(Map(%d)) = %d, got %d", a, a, back)
+		}
+
+		for b := 0; b < 256; b++ {
+			if iso.Map(aes.Mul(uint8(a), uint8(b))) != rs.Mul(mapped, iso.Map(uint8(b))) {
+				t.Fatalf("expected Map to preserve multiplication of %d and %d", a, b)
+			}
+			if iso.Map(aes.Add(uint8(a), uint8(b))) != rs.Add(mapped, iso.Map(uint8(b))) {
+				t.Fatalf("expected Map to preserve addition of %d and %d", a, b)
+			}
+		}
+	}
+
+	if iso.Map(0) != 0 || iso.Map(1) != 1 {
+		t.Errorf("expected Map to preserve the identity elements")
+	}
+}
+
+func TestIsomorphism_Degree32(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	// The reciprocal of an irreducible polynomial is also irreducible.
+	reciprocal := Polynomial(bits.Reverse64(uint64(PrimePolynomialDegree32)) >> 31)
+
+	src := NewField[uint32](PrimePolynomialDegree32)
+	dst := NewField[uint32](reciprocal)
+	iso := Isomorphism(src, dst)
+
+	for trial := 0; trial < 1000; trial++ {
+		a, b := rng.Uint32(), rng.Uint32()
+		if iso.Map(src.Mul(a, b)) != dst.Mul(iso.Map(a), iso.Map(b)) {
+			t.Fatalf("expected Map to preserve multiplication of %d and %d", a, b)
+		}
+		if iso.Inverse(iso.Map(a)) != a {
+			t.Fatalf("expected Inverse to undo Map for %d", a)
+		}
+	}
+}
+
+func TestIsomorphism_Panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected to panic when mapping fields of different orders")
+		}
+	}()
+	Isomorphism(NewField[uint16](PrimePolynomialDegree8), NewField[uint16](PrimePolynomialDegree9))
+}
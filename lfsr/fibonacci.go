@@ -0,0 +1,134 @@
+package lfsr
+
+import (
+	"math/bits"
+
+	"github.com/kklash/galois"
+)
+
+// Fibonacci is a linear feedback shift register in the Fibonacci (external
+// XOR) configuration. Each step, the register shifts by one bit, outputting
+// its last stage, and the XOR of the tapped stages is fed back into its first
+// stage.
+//
+// A feedback polynomial of degree n describes a register with n stages, where
+// each term x^k taps the k-th stage. For example, the polynomial x^7 + x^6 + 1
+// describes the PRBS7 register, whose output sequence satisfies:
+//
+//	s[t] = s[t-7] + s[t-6]
+//
+// The state of the register holds the next n output bits, with the next output
+// bit in the most-significant position.
+type Fibonacci struct {
+	poly  galois.Polynomial
+	state uint64
+}
+
+var _ Register = (*Fibonacci)(nil)
+
+// NewFibonacci creates a Fibonacci register with the given feedback polynomial
+// and seed. The seed is the initial state of the register, and so it also holds
+// the first n bits of output. A register seeded with zero only ever outputs zeros.
+//
+// Panics if poly has no constant term, or its degree is zero or greater than 63.
+func NewFibonacci(poly galois.Polynomial, seed uint64) *Fibonacci {
+	checkPolynomial(poly)
+	return &Fibonacci{
+		poly:  poly,
+		state: seed & mask(poly.Degree()),
+	}
+}
+
+// Polynomial returns the feedback polynomial of the register.
+func (lfsr *Fibonacci) Polynomial() galois.Polynomial {
+	return lfsr.poly
+}
+
+// State returns the current state of the register, which is also its next n
+// output bits.
+func (lfsr *Fibonacci) State() uint64 {
+	return lfsr.state
+}
+
+// Bit steps the register once and returns the output bit.
+func (lfsr *Fibonacci) Bit() uint8 {
+	degree := lfsr.poly.Degree()
+	out := uint8(lfsr.state >> (degree - 1))
+
+	// The term x^k taps the k-th stage, which is at bit index k-1.
+	taps := uint64(lfsr.poly>>1) & mask(degree)
+	feedback := uint64(bits.OnesCount64(lfsr.state&taps) & 1)
+
+	lfsr.state = (lfsr.state<<1 | feedback) & mask(degree)
+	return out
+}
+
+// Byte steps the register eight times, and returns the output bits packed into
+// a byte, with the first bit in the most-significant position.
+func (lfsr *Fibonacci) Byte() byte {
+	return byte(word(lfsr, 8))
+}
+
+// Word steps the register n times, and returns the output bits packed into an
+// integer, with the first bit in the most-significant position.
+//
+// Panics if n is greater than 64.
+func (lfsr *Fibonacci) Word(n int) uint64 {
+	return word(lfsr, n)
+}
+
+// Read fills buf with output bytes from the register, as though by calling Byte
+// repeatedly. It implements io.Reader, and never returns an error.
+func (lfsr *Fibonacci) Read(buf []byte) (int, error) {
+	return read(lfsr, buf)
+}
+
+// Jump advances the register by n steps in time proportional to log(n).
+//
+// The output sequence satisfies a linear recurrence whose characteristic
+// polynomial Q is the reciprocal of the feedback polynomial. If x^n mod Q is
+// c(x) = c[0] + c[1]*x + ..., then s[t+n] is the sum of c[i]*s[t+i], which lets
+// the new state be computed from the next 2d-1 output bits, where d is the degree
+// of the feedback polynomial.
+//
+// Panics if the degree of the feedback polynomial is greater than 32.
+func (lfsr *Fibonacci) Jump(n uint64) {
+	checkJumpDegree(lfsr.poly, "jump")
+	degree := lfsr.poly.Degree()
+	charPoly := reciprocal(lfsr.poly)
+	c := galois.Generator.Exp(n, charPoly).Mod(charPoly)
+
+	// Collect the next 2d-1 output bits, with s[t+i] at bit index i.
+	lookahead := *lfsr
+	var seq uint64
+	for i := uint64(0); i < 2*degree-1; i++ {
+		seq |= uint64(lookahead.Bit()) << i
+	}
+
+	var state uint64
+	for r := uint64(0); r < degree; r++ {
+		bit := uint64(bits.OnesCount64((seq>>r)&uint64(c)) & 1)
+		state |= bit << (degree - 1 - r)
+	}
+	lfsr.state = state
+}
+
+// Period returns the number of steps after which the register returns to its
+// current state. For irreducible feedback polynomials, this is the period of the
+// polynomial, which is 2^n - 1 for primitive polynomials. Otherwise the period
+// depends on the state, and is found by stepping the register, which takes time
+// proportional to the period.
+//
+// Panics if the degree of the feedback polynomial is greater than 32.
+func (lfsr *Fibonacci) Period() uint64 {
+	checkJumpDegree(lfsr.poly, "find period of")
+
+	if lfsr.state == 0 {
+		return 1
+	} else if lfsr.poly.IsIrreducible() {
+		return lfsr.poly.Period()
+	}
+
+	lookahead := *lfsr
+	return period(&lookahead)
+}
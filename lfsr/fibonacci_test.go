@@ -0,0 +1,118 @@
+package lfsr
+
+import (
+	"testing"
+
+	"github.com/kklash/galois"
+)
+
+func TestFibonacci_PRBS7(t *testing.T) {
+	// x^7 + x^6 + 1, seeded with all ones.
+	lfsr := NewFibonacci(0b11000001, 0b1111111)
+
+	if word := lfsr.Word(32); word != 0xFE041851 {
+		t.Errorf("expected first output word 0xFE041851, got 0x%X", word)
+	}
+}
+
+func TestFibonacci_Period(t *testing.T) {
+	primes := []galois.Polynomial{
+		galois.PrimePolynomialDegree2,
+		galois.PrimePolynomialDegree3,
+		galois.PrimePolynomialDegree4,
+		galois.PrimePolynomialDegree5,
+		galois.PrimePolynomialDegree6,
+		galois.PrimePolynomialDegree7,
+		galois.PrimePolynomialDegree8,
+		galois.PrimePolynomialDegree9,
+		galois.PrimePolynomialDegree10,
+		galois.PrimePolynomialDegree11,
+		galois.PrimePolynomialDegree12,
+	}
+
+	for _, prime := range primes {
+		lfsr := NewFibonacci(prime, 1)
+		expected := uint64(1)<<prime.Degree() - 1
+		if period := lfsr.Period(); period != expected {
+			t.Errorf("expected period of %s to be %d, got %d", prime, expected, period)
+		}
+
+		seen := make(map[uint64]bool)
+		for i := uint64(0); i < expected; i++ {
+			if seen[lfsr.State()] {
+				t.Errorf("state %b of %s repeated after %d steps", lfsr.State(), prime, i)
+				break
+			}
+			seen[lfsr.State()] = true
+			lfsr.Bit()
+		}
+		if lfsr.State() != 1 {
+			t.Errorf("expected %s to return to seed after %d steps", prime, expected)
+		}
+	}
+
+	// (x^2 + x + 1)(x^3 + x + 1), whose period depends on the seed.
+	reducible := galois.PrimePolynomialDegree2.Mul(galois.PrimePolynomialDegree3)
+	if period := NewFibonacci(reducible, 0b11111).Period(); period != 21 {
+		t.Errorf("expected period of reducible polynomial to be 21, got %d", period)
+	}
+	if period := NewFibonacci(reducible, 0).Period(); period != 1 {
+		t.Errorf("expected period of zero state to be 1, got %d", period)
+	}
+}
+
+func TestFibonacci_Jump(t *testing.T) {
+	polys := []galois.Polynomial{
+		0b11000001,
+		galois.PrimePolynomialDegree16,
+		galois.PrimePolynomialDegree31,
+		galois.PrimePolynomialDegree32,
+		galois.PrimePolynomialDegree2.Mul(galois.PrimePolynomialDegree5),
+	}
+
+	for _, poly := range polys {
+		for _, n := range []uint64{0, 1, 2, 31, 32, 33, 1000, 4321} {
+			stepped := NewFibonacci(poly, 0xDEADBEEF)
+			for i := uint64(0); i < n; i++ {
+				stepped.Bit()
+			}
+
+			jumped := NewFibonacci(poly, 0xDEADBEEF)
+			jumped.Jump(n)
+
+			if jumped.State() != stepped.State() {
+				t.Errorf(
+					"jumping %s ahead %d steps produced state %X; expected %X",
+					poly, n, jumped.State(), stepped.State(),
+				)
+			}
+		}
+	}
+}
+
+func BenchmarkFibonacci_Byte(b *testing.B) {
+	lfsr := NewFibonacci(galois.PrimePolynomialDegree31, 1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lfsr.Byte()
+	}
+}
+
+func TestFibonacci_JumpLargeDegree(t *testing.T) {
+	// x^40 + x^38 + x^21 + x^19 + 1 is too large for Jump and Period.
+	poly := galois.Polynomial(1<<40 | 1<<38 | 1<<21 | 1<<19 | 1)
+
+	for name, fn := range map[string]func(*Fibonacci){
+		"Jump":   func(lfsr *Fibonacci) { lfsr.Jump(30) },
+		"Period": func(lfsr *Fibonacci) { lfsr.Period() },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected %s to panic for feedback polynomial of degree 40", name)
+				}
+			}()
+			fn(NewFibonacci(poly, 1))
+		}()
+	}
+}
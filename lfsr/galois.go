@@ -0,0 +1,102 @@
+package lfsr
+
+import (
+	"github.com/kklash/galois"
+)
+
+// Galois is a linear feedback shift register in the Galois (internal XOR)
+// configuration. Each step, the register shifts by one bit, outputting its
+// last stage, and if that bit is one, the register is XORed with the taps of
+// the feedback polynomial.
+//
+// The state of a Galois register of degree n is a polynomial of degree less
+// than n. Each step multiplies the state by x modulo the feedback polynomial,
+// and outputs the coefficient of x^(n-1) from before the step.
+type Galois struct {
+	poly  galois.Polynomial
+	state galois.Polynomial
+}
+
+var _ Register = (*Galois)(nil)
+
+// NewGalois creates a Galois register with the given feedback polynomial and
+// seed. A register seeded with zero only ever outputs zeros.
+//
+// Panics if poly has no constant term, or its degree is zero or greater than 63.
+func NewGalois(poly galois.Polynomial, seed uint64) *Galois {
+	checkPolynomial(poly)
+	return &Galois{
+		poly:  poly,
+		state: galois.Polynomial(seed & mask(poly.Degree())),
+	}
+}
+
+// Polynomial returns the feedback polynomial of the register.
+func (lfsr *Galois) Polynomial() galois.Polynomial {
+	return lfsr.poly
+}
+
+// State returns the current state of the register.
+func (lfsr *Galois) State() uint64 {
+	return uint64(lfsr.state)
+}
+
+// Bit steps the register once and returns the output bit.
+func (lfsr *Galois) Bit() uint8 {
+	out := uint8(lfsr.state >> (lfsr.poly.Degree() - 1))
+	lfsr.state <<= 1
+	if out == 1 {
+		lfsr.state ^= lfsr.poly
+	}
+	return out
+}
+
+// Byte steps the register eight times, and returns the output bits packed into
+// a byte, with the first bit in the most-significant position.
+func (lfsr *Galois) Byte() byte {
+	return byte(word(lfsr, 8))
+}
+
+// Word steps the register n times, and returns the output bits packed into an
+// integer, with the first bit in the most-significant position.
+//
+// Panics if n is greater than 64.
+func (lfsr *Galois) Word(n int) uint64 {
+	return word(lfsr, n)
+}
+
+// Read fills buf with output bytes from the register, as though by calling Byte
+// repeatedly. It implements io.Reader, and never returns an error.
+func (lfsr *Galois) Read(buf []byte) (int, error) {
+	return read(lfsr, buf)
+}
+
+// Jump advances the register by n steps in time proportional to log(n), by
+// multiplying the state by x^n modulo the feedback polynomial.
+//
+// Panics if the degree of the feedback polynomial is greater than 32.
+func (lfsr *Galois) Jump(n uint64) {
+	checkJumpDegree(lfsr.poly, "jump")
+	xn := galois.Generator.Exp(n, lfsr.poly).Mod(lfsr.poly)
+	lfsr.state = lfsr.state.Mul(xn).Mod(lfsr.poly)
+}
+
+// Period returns the number of steps after which the register returns to its
+// current state. For irreducible feedback polynomials, this is the period of the
+// polynomial, which is 2^n - 1 for primitive polynomials. Otherwise the period
+// depends on the state, and is found by stepping the register, which takes time
+// proportional to the period.
+//
+// Panics if the degree of the feedback polynomial is greater than 32.
+func (lfsr *Galois) Period() uint64 {
+	checkJumpDegree(lfsr.poly, "find period of")
+
+	if lfsr.state == 0 {
+		return 1
+	} else if lfsr.poly.IsIrreducible() {
+		return lfsr.poly.Period()
+	}
+
+	lookahead := *lfsr
+	return period(&lookahead)
+}
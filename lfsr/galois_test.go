@@ -0,0 +1,110 @@
+package lfsr
+
+import (
+	"testing"
+
+	"github.com/kklash/galois"
+)
+
+func TestGalois_Period(t *testing.T) {
+	primes := []galois.Polynomial{
+		galois.PrimePolynomialDegree2,
+		galois.PrimePolynomialDegree3,
+		galois.PrimePolynomialDegree4,
+		galois.PrimePolynomialDegree5,
+		galois.PrimePolynomialDegree6,
+		galois.PrimePolynomialDegree7,
+		galois.PrimePolynomialDegree8,
+		galois.PrimePolynomialDegree9,
+		galois.PrimePolynomialDegree10,
+		galois.PrimePolynomialDegree11,
+		galois.PrimePolynomialDegree12,
+	}
+
+	for _, prime := range primes {
+		lfsr := NewGalois(prime, 1)
+		expected := uint64(1)<<prime.Degree() - 1
+		if period := lfsr.Period(); period != expected {
+			t.Errorf("expected period of %s to be %d, got %d", prime, expected, period)
+		}
+
+		// The state of a Galois register is x^t mod p, so it should trace
+		// out every non-zero element of the field generated by p.
+		field := galois.NewField[galois.Polynomial](prime)
+		for i := uint64(0); i < expected; i++ {
+			if state := galois.Polynomial(lfsr.State()); state != field.Generate(i) {
+				t.Errorf("expected state of %s at step %d to be %s, got %s", prime, i, field.Generate(i), state)
+				break
+			}
+			lfsr.Bit()
+		}
+	}
+
+	// x^4 + x^3 + x^2 + x + 1 is irreducible but not primitive.
+	if period := NewGalois(0b11111, 1).Period(); period != 5 {
+		t.Errorf("expected period of x^4 + x^3 + x^2 + x + 1 to be 5, got %d", period)
+	}
+}
+
+func TestGalois_Jump(t *testing.T) {
+	polys := []galois.Polynomial{
+		galois.PrimePolynomialDegree8,
+		galois.PrimePolynomialDegree23,
+		galois.PrimePolynomialDegree32,
+		galois.PrimePolynomialDegree3.Mul(galois.PrimePolynomialDegree4),
+	}
+
+	for _, poly := range polys {
+		for _, n := range []uint64{0, 1, 2, 31, 32, 33, 1000, 4321} {
+			stepped := NewGalois(poly, 0x1234567)
+			for i := uint64(0); i < n; i++ {
+				stepped.Bit()
+			}
+
+			jumped := NewGalois(poly, 0x1234567)
+			jumped.Jump(n)
+
+			if jumped.State() != stepped.State() {
+				t.Errorf(
+					"jumping %s ahead %d steps produced state %X; expected %X",
+					poly, n, jumped.State(), stepped.State(),
+				)
+			}
+		}
+	}
+}
+
+func TestGalois_JumpLargeDegree(t *testing.T) {
+	// x^40 + x^38 + x^21 + x^19 + 1 is too large for Jump and Period.
+	poly := galois.Polynomial(1<<40 | 1<<38 | 1<<21 | 1<<19 | 1)
+
+	for name, fn := range map[string]func(*Galois){
+		"Jump":   func(lfsr *Galois) { lfsr.Jump(30) },
+		"Period": func(lfsr *Galois) { lfsr.Period() },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected %s to panic for feedback polynomial of degree 40", name)
+				}
+			}()
+			fn(NewGalois(poly, 1))
+		}()
+	}
+}
+
+func TestGalois_Read(t *testing.T) {
+	a := NewGalois(galois.PrimePolynomialDegree16, 0xACE1)
+	b := NewGalois(galois.PrimePolynomialDegree16, 0xACE1)
+
+	buf := make([]byte, 16)
+	if n, err := a.Read(buf); n != len(buf) || err != nil {
+		t.Fatalf("unexpected result from Read: %d, %v", n, err)
+	}
+
+	for i, v := range buf {
+		if expected := b.Byte(); v != expected {
+			t.Errorf("expected byte %d to be 0x%X, got 0x%X", i, expected, v)
+		}
+	}
+}
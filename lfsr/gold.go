@@ -0,0 +1,212 @@
+package lfsr
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kklash/galois"
+)
+
+// MSequence returns one period of the maximal-length sequence produced by a
+// Fibonacci register with the given primitive feedback polynomial, seeded with
+// all ones. Each element of the sequence is zero or one.
+//
+// Panics if poly is not primitive.
+func MSequence(poly galois.Polynomial) []uint8 {
+	if !poly.IsPrimitive() {
+		panic(fmt.Sprintf("lfsr: cannot produce m-sequence from non-primitive polynomial %s", poly))
+	}
+	register := NewFibonacci(poly, mask(poly.Degree()))
+
+	seq := make([]uint8, mask(poly.Degree()))
+	for i := range seq {
+		seq[i] = register.Bit()
+	}
+	return seq
+}
+
+// Decimate returns the sequence formed by taking every q-th element of the
+// periodic sequence seq, so that the output v[t] = seq[q*t mod len(seq)].
+func Decimate(seq []uint8, q uint64) []uint8 {
+	n := uint64(len(seq))
+	out := make([]uint8, n)
+	for t := uint64(0); t < n; t++ {
+		out[t] = seq[(q*t)%n]
+	}
+	return out
+}
+
+// CrossCorrelation returns the periodic cross-correlation of the binary sequences
+// a and b, which must have the same length. Each bit is mapped to +1 or -1, so
+// that element k of the output counts the positions where a[t] and b[t+k] agree,
+// minus those where they differ.
+//
+// The cross-correlation of a sequence with itself is its autocorrelation.
+//
+// Panics if a and b have different lengths.
+func CrossCorrelation(a, b []uint8) []int {
+	if len(a) != len(b) {
+		panic("lfsr: cannot correlate sequences of different lengths")
+	}
+
+	n := len(a)
+	out := make([]int, n)
+	for k := 0; k < n; k++ {
+		sum := 0
+		for t := 0; t < n; t++ {
+			if a[t] == b[(t+k)%n] {
+				sum++
+			} else {
+				sum--
+			}
+		}
+		out[k] = sum
+	}
+	return out
+}
+
+// goldBound returns t(n) = 1 + 2^floor((n+2)/2), which bounds the cross-correlation
+// magnitude of a preferred pair of m-sequences of degree n.
+func goldBound(degree uint64) int {
+	return 1 + 1<<((degree+2)/2)
+}
+
+// IsPreferredPair returns true if p1 and p2 are a preferred pair of primitive
+// polynomials: they have the same degree n, and the cross-correlation of their
+// m-sequences takes only the three values -1, -t(n) and t(n) - 2, where
+// t(n) = 1 + 2^floor((n+2)/2).
+func IsPreferredPair(p1, p2 galois.Polynomial) bool {
+	degree := p1.Degree()
+	if p2.Degree() != degree || p1 == p2 || !p1.IsPrimitive() || !p2.IsPrimitive() {
+		return false
+	}
+
+	t := goldBound(degree)
+	for _, c := range CrossCorrelation(MSequence(p1), MSequence(p2)) {
+		if c != -1 && c != -t && c != t-2 {
+			return false
+		}
+	}
+	return true
+}
+
+// PrimitivePolynomials returns every primitive polynomial of the given degree,
+// in ascending order.
+//
+// Panics if degree is zero or greater than 32.
+func PrimitivePolynomials(degree uint64) []galois.Polynomial {
+	if degree == 0 || degree > 32 {
+		panic(fmt.Sprintf("lfsr: cannot search for primitive polynomials of degree %d", degree))
+	}
+
+	var polys []galois.Polynomial
+	for p := galois.Polynomial(1)<<degree | 1; p < galois.Polynomial(1)<<(degree+1); p += 2 {
+		if p.IsPrimitive() {
+			polys = append(polys, p)
+		}
+	}
+	return polys
+}
+
+// PreferredPairs returns every preferred pair of primitive polynomials of the given
+// degree, with the lesser polynomial first in each pair. Preferred pairs do not
+// exist when the degree is a multiple of four.
+//
+// Candidates are found by decimating the m-sequence of each primitive polynomial
+// by q = 2^k + 1 or q = 2^(2k) - 2^k + 1, where gcd(n, k) is one for odd n, or two
+// for even n. The polynomial of the decimated sequence is recovered using
+// galois.BerlekampMassey, and each candidate pair is verified with IsPreferredPair.
+func PreferredPairs(degree uint64) [][2]galois.Polynomial {
+	if degree%4 == 0 {
+		return nil
+	}
+
+	wantGCD := uint64(1)
+	if degree%2 == 0 {
+		wantGCD = 2
+	}
+
+	var decimations []uint64
+	for k := uint64(1); k < degree; k++ {
+		if gcd(degree, k) == wantGCD {
+			decimations = append(decimations, 1<<k+1, 1<<(2*k)-1<<k+1)
+		}
+	}
+
+	seen := make(map[[2]galois.Polynomial]bool)
+	var pairs [][2]galois.Polynomial
+	for _, p1 := range PrimitivePolynomials(degree) {
+		u := MSequence(p1)
+		for _, q := range decimations {
+			p2, _ := galois.BerlekampMassey(Decimate(u, q)[:2*degree])
+
+			pair := [2]galois.Polynomial{p1, p2}
+			if p2 < p1 {
+				pair = [2]galois.Polynomial{p2, p1}
+			}
+			if seen[pair] {
+				continue
+			}
+			seen[pair] = true
+
+			if IsPreferredPair(pair[0], pair[1]) {
+				pairs = append(pairs, pair)
+			}
+		}
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] == pairs[j][0] {
+			return pairs[i][1] < pairs[j][1]
+		}
+		return pairs[i][0] < pairs[j][0]
+	})
+	return pairs
+}
+
+// GoldCodes returns the family of 2^n + 1 Gold codes generated by the preferred
+// pair of primitive polynomials p1 and p2 of degree n. The family consists of
+// the m-sequences u and v of p1 and p2, followed by u XOR T^k(v) for every shift
+// k from 0 to 2^n - 2, where T^k(v)[t] = v[t+k].
+//
+// Every Gold code has period 2^n - 1. The cross-correlation between any two
+// codes, and the out-of-phase autocorrelation of any code, takes only the values
+// -1, -t(n) and t(n) - 2, where t(n) = 1 + 2^floor((n+2)/2).
+//
+// GoldCodes does not check that p1 and p2 are a preferred pair; use
+// IsPreferredPair for this.
+//
+// Panics if p1 and p2 have different degrees or are not primitive.
+func GoldCodes(p1, p2 galois.Polynomial) [][]uint8 {
+	if p1.Degree() != p2.Degree() {
+		panic(fmt.Sprintf("lfsr: cannot generate Gold codes from %s and %s of differing degree", p1, p2))
+	}
+	return goldCodes(MSequence(p1), MSequence(p2))
+}
+
+// goldCodes returns u, v, and u XOR T^k(v) for every shift k.
+func goldCodes(u, v []uint8) [][]uint8 {
+	codes := make([][]uint8, 0, len(u)+2)
+	codes = append(codes, u, v)
+	for k := range v {
+		codes = append(codes, xorShifted(u, v, k))
+	}
+	return codes
+}
+
+// xorShifted returns a XOR T^k(b).
+func xorShifted(a, b []uint8, k int) []uint8 {
+	out := make([]uint8, len(a))
+	for t := range a {
+		out[t] = a[t] ^ b[(t+k)%len(b)]
+	}
+	return out
+}
+
+// gcd returns the greatest common divisor of a and b.
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,122 @@
+package lfsr
+
+import (
+	"testing"
+
+	"github.com/kklash/galois"
+)
+
+func TestGoldCodes_GPS(t *testing.T) {
+	// The GPS C/A codes are Gold codes generated by G1 = 1 + x^3 + x^10 and
+	// G2 = 1 + x^2 + x^3 + x^6 + x^8 + x^9 + x^10. Each satellite's code is G1
+	// XORed with G2 delayed by a number of chips. The first 10 chips of each code
+	// are given in octal in IS-GPS-200.
+	g1 := galois.Polynomial(1<<10 | 1<<3 | 1)
+	g2 := galois.Polynomial(1<<10 | 1<<9 | 1<<8 | 1<<6 | 1<<3 | 1<<2 | 1)
+
+	type TestCase struct {
+		PRN        int
+		Delay      int
+		FirstChips uint64
+	}
+
+	testCases := []TestCase{
+		{PRN: 1, Delay: 5, FirstChips: 01440},
+		{PRN: 2, Delay: 6, FirstChips: 01620},
+		{PRN: 3, Delay: 7, FirstChips: 01710},
+		{PRN: 4, Delay: 8, FirstChips: 01744},
+		{PRN: 5, Delay: 17, FirstChips: 01133},
+		{PRN: 6, Delay: 18, FirstChips: 01455},
+		{PRN: 7, Delay: 139, FirstChips: 01131},
+		{PRN: 8, Delay: 140, FirstChips: 01454},
+		{PRN: 9, Delay: 141, FirstChips: 01626},
+		{PRN: 10, Delay: 251, FirstChips: 01504},
+	}
+
+	if !IsPreferredPair(g1, g2) {
+		t.Fatalf("expected GPS G1 and G2 to be a preferred pair")
+	}
+
+	codes := GoldCodes(g1, g2)
+	if len(codes) != 1025 {
+		t.Fatalf("expected 1025 Gold codes, got %d", len(codes))
+	}
+
+	for _, test := range testCases {
+		// Delaying v by d chips is the same as advancing it by 1023 - d.
+		code := codes[2+1023-test.Delay]
+
+		var chips uint64
+		for _, chip := range code[:10] {
+			chips = chips<<1 | uint64(chip)
+		}
+		if chips != test.FirstChips {
+			t.Errorf("expected PRN %d to begin with %04o, got %04o", test.PRN, test.FirstChips, chips)
+		}
+	}
+}
+
+func TestGoldCodes_Correlation(t *testing.T) {
+	pairs := PreferredPairs(5)
+	if len(pairs) == 0 {
+		t.Fatalf("expected to find preferred pairs of degree 5")
+	}
+
+	// t(5) = 1 + 2^3
+	allowed := map[int]bool{-1: true, -9: true, 7: true}
+
+	codes := GoldCodes(pairs[0][0], pairs[0][1])
+	if len(codes) != 33 {
+		t.Fatalf("expected 33 Gold codes of degree 5, got %d", len(codes))
+	}
+
+	for i := range codes {
+		for j := i; j < len(codes); j++ {
+			for k, c := range CrossCorrelation(codes[i], codes[j]) {
+				if i == j && k == 0 {
+					continue
+				}
+				if !allowed[c] {
+					t.Fatalf("unexpected correlation %d between Gold codes %d and %d at shift %d", c, i, j, k)
+				}
+			}
+		}
+	}
+}
+
+func TestPreferredPairs(t *testing.T) {
+	for _, degree := range []uint64{3, 5, 6, 7} {
+		pairs := PreferredPairs(degree)
+		if len(pairs) == 0 {
+			t.Errorf("expected to find preferred pairs of degree %d", degree)
+		}
+		for _, pair := range pairs {
+			if pair[0] >= pair[1] || !IsPreferredPair(pair[0], pair[1]) {
+				t.Errorf("invalid preferred pair %s, %s", pair[0], pair[1])
+			}
+		}
+	}
+
+	if pairs := PreferredPairs(8); pairs != nil {
+		t.Errorf("expected no preferred pairs of degree 8, got %v", pairs)
+	}
+
+	// x^5 + x^2 + 1 and x^5 + x^4 + x^3 + x^2 + 1 are the textbook preferred pair.
+	found := false
+	for _, pair := range PreferredPairs(5) {
+		found = found || pair == [2]galois.Polynomial{0b100101, 0b111101}
+	}
+	if !found {
+		t.Errorf("expected to find preferred pair x^5 + x^2 + 1, x^5 + x^4 + x^3 + x^2 + 1")
+	}
+}
+
+func TestPrimitivePolynomials(t *testing.T) {
+	// There are phi(2^n - 1) / n primitive polynomials of degree n.
+	expected := map[uint64]int{2: 1, 3: 2, 4: 2, 5: 6, 6: 6, 7: 18, 8: 16, 10: 60}
+	for degree, count := range expected {
+		if polys := PrimitivePolynomials(degree); len(polys) != count {
+			t.Errorf("expected %d primitive polynomials of degree %d, got %d", count, degree, len(polys))
+		}
+	}
+}
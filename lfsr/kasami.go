@@ -0,0 +1,70 @@
+package lfsr
+
+import (
+	"fmt"
+
+	"github.com/kklash/galois"
+)
+
+// KasamiSmall returns the small set of 2^(n/2) Kasami sequences generated by the
+// primitive polynomial poly of even degree n. The set consists of the m-sequence
+// u of poly, followed by u XOR T^k(w) for every shift k from 0 to 2^(n/2) - 2,
+// where w is u decimated by 2^(n/2) + 1.
+//
+// Every sequence has period 2^n - 1. The cross-correlation between any two
+// sequences, and the out-of-phase autocorrelation of any sequence, takes only
+// the values -1, -2^(n/2) - 1 and 2^(n/2) - 1, which meets the Welch bound.
+//
+// Panics if poly is not primitive or its degree is odd.
+func KasamiSmall(poly galois.Polynomial) [][]uint8 {
+	degree := poly.Degree()
+	if degree%2 != 0 {
+		panic(fmt.Sprintf("lfsr: cannot generate Kasami sequences from %s of odd degree", poly))
+	}
+
+	u := MSequence(poly)
+	w := Decimate(u, 1<<(degree/2)+1)
+	wPeriod := int(mask(degree / 2))
+
+	seqs := make([][]uint8, 0, wPeriod+1)
+	seqs = append(seqs, u)
+	for k := 0; k < wPeriod; k++ {
+		seqs = append(seqs, xorShifted(u, w, k))
+	}
+	return seqs
+}
+
+// KasamiLarge returns the large set of (2^n + 1) * 2^(n/2) Kasami sequences
+// generated by the primitive polynomial poly, whose degree n must be two more than
+// a multiple of four. The large set contains the Gold codes formed by the
+// m-sequence u of poly and its decimation v by 2^((n+2)/2) + 1, and each of those
+// codes XORed with T^k(w) for every shift k from 0 to 2^(n/2) - 2, where w is u
+// decimated by 2^(n/2) + 1.
+//
+// Every sequence has period 2^n - 1. The cross-correlation between any two
+// sequences, and the out-of-phase autocorrelation of any sequence, is bounded in
+// magnitude by 1 + 2^((n+2)/2).
+//
+// Panics if poly is not primitive or its degree is not two more than a multiple
+// of four.
+func KasamiLarge(poly galois.Polynomial) [][]uint8 {
+	degree := poly.Degree()
+	if degree%4 != 2 {
+		panic(fmt.Sprintf("lfsr: cannot generate large Kasami set from %s of degree %d", poly, degree))
+	}
+
+	u := MSequence(poly)
+	v := Decimate(u, 1<<((degree+2)/2)+1)
+	w := Decimate(u, 1<<(degree/2)+1)
+	wPeriod := int(mask(degree / 2))
+
+	gold := goldCodes(u, v)
+	seqs := make([][]uint8, 0, len(gold)*(wPeriod+1))
+	seqs = append(seqs, gold...)
+	for _, code := range gold {
+		for k := 0; k < wPeriod; k++ {
+			seqs = append(seqs, xorShifted(code, w, k))
+		}
+	}
+	return seqs
+}
@@ -0,0 +1,51 @@
+package lfsr
+
+import (
+	"testing"
+
+	"github.com/kklash/galois"
+)
+
+func TestKasamiSmall(t *testing.T) {
+	seqs := KasamiSmall(galois.PrimePolynomialDegree6)
+	if len(seqs) != 8 {
+		t.Fatalf("expected 8 sequences in small Kasami set of degree 6, got %d", len(seqs))
+	}
+
+	allowed := map[int]bool{-1: true, -9: true, 7: true}
+	for i := range seqs {
+		for j := i; j < len(seqs); j++ {
+			for k, c := range CrossCorrelation(seqs[i], seqs[j]) {
+				if i == j && k == 0 {
+					continue
+				}
+				if !allowed[c] {
+					t.Fatalf("unexpected correlation %d between Kasami sequences %d and %d at shift %d", c, i, j, k)
+				}
+			}
+		}
+	}
+}
+
+func TestKasamiLarge(t *testing.T) {
+	seqs := KasamiLarge(galois.PrimePolynomialDegree6)
+	if len(seqs) != 65*8 {
+		t.Fatalf("expected 520 sequences in large Kasami set of degree 6, got %d", len(seqs))
+	}
+
+	allowed := map[int]bool{-1: true, 7: true, -9: true, 15: true, -17: true}
+
+	// Checking every pair is slow, so check each sequence against a spread of others.
+	for i := range seqs {
+		for j := i; j < len(seqs); j += 37 {
+			for k, c := range CrossCorrelation(seqs[i], seqs[j]) {
+				if i == j && k == 0 {
+					continue
+				}
+				if !allowed[c] {
+					t.Fatalf("unexpected correlation %d between Kasami sequences %d and %d at shift %d", c, i, j, k)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Package lfsr implements linear feedback shift registers (LFSRs) in both the
+// Fibonacci and Galois configurations, with feedback defined by a galois.Polynomial.
+//
+// When the feedback polynomial is primitive, such as any of the
+// galois.PrimePolynomialDegreeN constants, the register produces a
+// maximal-length sequence (m-sequence) which repeats only after 2^n - 1 bits.
+package lfsr
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/kklash/galois"
+)
+
+// Register is a linear feedback shift register producing a stream of bits.
+type Register interface {
+	// Bit steps the register once and returns the output bit, either zero or one.
+	Bit() uint8
+
+	// Jump advances the register by n steps, as though Bit had been called n times.
+	Jump(n uint64)
+
+	// Period returns the number of steps after which the register returns to its
+	// current state.
+	Period() uint64
+
+	// State returns the current contents of the register.
+	State() uint64
+}
+
+// checkPolynomial panics if poly cannot be used as a feedback polynomial.
+func checkPolynomial(poly galois.Polynomial) {
+	if degree := poly.Degree(); degree == 0 || degree > 63 {
+		panic(fmt.Sprintf("lfsr: feedback polynomial degree must be between 1 and 63; got %d", degree))
+	}
+	if poly&1 == 0 {
+		panic(fmt.Sprintf("lfsr: feedback polynomial %s has no constant term", poly))
+	}
+}
+
+// maxJumpDegree is the largest degree of feedback polynomial supported by Jump
+// and Period, which multiply polynomials modulo the feedback polynomial, and so
+// need products of degree up to twice its degree to fit in a galois.Polynomial.
+const maxJumpDegree = 32
+
+// checkJumpDegree panics if poly has too high a degree for Jump and Period. The
+// operation names the method, for the panic message.
+func checkJumpDegree(poly galois.Polynomial, operation string) {
+	if degree := poly.Degree(); degree > maxJumpDegree {
+		panic(fmt.Sprintf("lfsr: cannot %s register of degree %d; maximum degree is %d", operation, degree, maxJumpDegree))
+	}
+}
+
+// word steps the register n times and returns the output bits, with the first
+// output bit in the most-significant position.
+func word(r Register, n int) (w uint64) {
+	if n > 64 {
+		panic("lfsr: cannot read more than 64 bits into a word")
+	}
+	for i := 0; i < n; i++ {
+		w = w<<1 | uint64(r.Bit())
+	}
+	return
+}
+
+// read fills buf with output bytes from the register.
+func read(r Register, buf []byte) (int, error) {
+	for i := range buf {
+		buf[i] = byte(word(r, 8))
+	}
+	return len(buf), nil
+}
+
+// period finds the period of the register by stepping a copy of it until it
+// returns to its starting state. This is used when the feedback polynomial is
+// reducible, in which case the period depends on the seed.
+func period(r Register) uint64 {
+	start := r.State()
+	n := uint64(0)
+	for {
+		r.Bit()
+		n++
+		if r.State() == start {
+			return n
+		}
+	}
+}
+
+// reciprocal returns the reciprocal of poly, x^n * poly(1/x), whose coefficients
+// are those of poly in reverse order.
+func reciprocal(poly galois.Polynomial) galois.Polynomial {
+	return galois.Polynomial(bits.Reverse64(uint64(poly)) >> (63 - poly.Degree()))
+}
+
+// mask returns a bitmask covering the lowest n bits.
+func mask(n uint64) uint64 {
+	return 1<<n - 1
+}
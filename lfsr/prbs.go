@@ -0,0 +1,224 @@
+package lfsr
+
+import (
+	"math/bits"
+
+	"github.com/kklash/galois"
+)
+
+// Pattern describes a standard pseudo-random binary sequence (PRBS), as used by
+// bit-error-rate testers and defined in ITU-T O.150. Each pattern is the
+// maximal-length sequence of a Fibonacci register with a primitive feedback
+// polynomial.
+type Pattern struct {
+	// Name is the conventional name of the pattern, such as "PRBS7".
+	Name string
+
+	// Poly is the feedback polynomial of the pattern's Fibonacci register.
+	Poly galois.Polynomial
+
+	// Seed is the initial state of the register.
+	Seed uint64
+
+	// Inverted indicates that every output bit of the register is inverted.
+	Inverted bool
+}
+
+// Standard PRBS patterns. All are seeded with ones. As specified by ITU-T O.150,
+// the PRBS15, PRBS23 and PRBS31 patterns are inverted.
+var (
+	PRBS7  = Pattern{Name: "PRBS7", Poly: 1<<7 | 1<<6 | 1, Seed: 1<<7 - 1}
+	PRBS9  = Pattern{Name: "PRBS9", Poly: 1<<9 | 1<<5 | 1, Seed: 1<<9 - 1}
+	PRBS11 = Pattern{Name: "PRBS11", Poly: 1<<11 | 1<<9 | 1, Seed: 1<<11 - 1}
+	PRBS15 = Pattern{Name: "PRBS15", Poly: 1<<15 | 1<<14 | 1, Seed: 1<<15 - 1, Inverted: true}
+	PRBS23 = Pattern{Name: "PRBS23", Poly: 1<<23 | 1<<18 | 1, Seed: 1<<23 - 1, Inverted: true}
+	PRBS31 = Pattern{Name: "PRBS31", Poly: 1<<31 | 1<<28 | 1, Seed: 1<<31 - 1, Inverted: true}
+)
+
+// Invert returns a copy of the pattern with its output inverted.
+func (pattern Pattern) Invert() Pattern {
+	pattern.Inverted = !pattern.Inverted
+	return pattern
+}
+
+// NewGenerator returns a Generator which produces the pattern from its seed.
+func (pattern Pattern) NewGenerator() *Generator {
+	gen := &Generator{register: NewFibonacci(pattern.Poly, pattern.Seed)}
+	if pattern.Inverted {
+		gen.invert = 1
+	}
+	return gen
+}
+
+// NewChecker returns a Checker which synchronizes to and checks a received copy
+// of the pattern.
+func (pattern Pattern) NewChecker() *Checker {
+	checker := &Checker{
+		degree: pattern.Poly.Degree(),
+		taps:   uint64(pattern.Poly>>1) & mask(pattern.Poly.Degree()),
+	}
+	if pattern.Inverted {
+		checker.invert = 1
+	}
+	return checker
+}
+
+// Generator produces a PRBS pattern.
+type Generator struct {
+	register *Fibonacci
+	invert   uint8
+}
+
+var _ Register = (*Generator)(nil)
+
+// Bit returns the next bit of the pattern.
+func (gen *Generator) Bit() uint8 {
+	return gen.register.Bit() ^ gen.invert
+}
+
+// Byte returns the next eight bits of the pattern, with the first bit in the
+// most-significant position.
+func (gen *Generator) Byte() byte {
+	return byte(word(gen, 8))
+}
+
+// Word returns the next n bits of the pattern, with the first bit in the
+// most-significant position.
+//
+// Panics if n is greater than 64.
+func (gen *Generator) Word(n int) uint64 {
+	return word(gen, n)
+}
+
+// Read fills buf with the next bytes of the pattern, as though by calling Byte
+// repeatedly. It implements io.Reader, and never returns an error.
+func (gen *Generator) Read(buf []byte) (int, error) {
+	return read(gen, buf)
+}
+
+// Jump skips the next n bits of the pattern.
+func (gen *Generator) Jump(n uint64) {
+	gen.register.Jump(n)
+}
+
+// Period returns the length of the pattern, which is 2^n - 1 for a pattern whose
+// feedback polynomial has degree n.
+func (gen *Generator) Period() uint64 {
+	return gen.register.Period()
+}
+
+// State returns the state of the underlying Fibonacci register, which holds the
+// next n bits of the pattern before inversion.
+func (gen *Generator) State() uint64 {
+	return gen.register.State()
+}
+
+// Checker is a self-synchronizing PRBS receiver, which counts the bit errors in
+// a received pattern. It needs no knowledge of the pattern's seed or phase.
+//
+// Until it is synchronized, the Checker loads received bits into its register,
+// and predicts each next bit from them. After n consecutive correct predictions,
+// where n is the degree of the pattern's polynomial, the Checker is synchronized.
+// From then on, the Checker counts every received bit which differs from its
+// prediction as an error. It feeds its predictions back into the register rather
+// than the received bits, so that each bit error is counted only once.
+//
+// If more than a quarter of the last 64 checked bits were errors, the Checker
+// assumes it has lost synchronization, and starts to resynchronize.
+type Checker struct {
+	degree uint64
+	taps   uint64
+	invert uint8
+
+	state   uint64
+	loaded  uint64
+	run     uint64
+	synced  bool
+	history uint64
+
+	bits   uint64
+	errors uint64
+}
+
+// maxRecentErrors is the number of errors in the last 64 checked bits above
+// which a Checker loses synchronization.
+const maxRecentErrors = 16
+
+// Bit feeds a received bit into the Checker. It returns true if the Checker is
+// synchronized and the bit was an error.
+func (checker *Checker) Bit(bit uint8) bool {
+	bit = (bit ^ checker.invert) & 1
+	predicted := uint8(bits.OnesCount64(checker.state&checker.taps) & 1)
+
+	if !checker.synced {
+		if checker.loaded >= checker.degree && predicted == bit {
+			checker.run++
+		} else {
+			checker.run = 0
+		}
+		checker.loaded++
+		checker.shift(bit)
+
+		if checker.run >= checker.degree {
+			checker.synced = true
+			checker.history = 0
+		}
+		return false
+	}
+
+	isError := predicted != bit
+	checker.bits++
+	checker.history <<= 1
+	if isError {
+		checker.errors++
+		checker.history |= 1
+	}
+	checker.shift(predicted)
+
+	if bits.OnesCount64(checker.history) > maxRecentErrors {
+		checker.synced = false
+		checker.loaded = 0
+		checker.run = 0
+	}
+	return isError
+}
+
+// Write feeds every bit of the given bytes into the Checker, most-significant
+// bit first. It implements io.Writer, and never returns an error.
+func (checker *Checker) Write(buf []byte) (int, error) {
+	for _, b := range buf {
+		for i := 7; i >= 0; i-- {
+			checker.Bit(b >> i)
+		}
+	}
+	return len(buf), nil
+}
+
+// shift shifts a bit into the checker's register.
+func (checker *Checker) shift(bit uint8) {
+	checker.state = (checker.state<<1 | uint64(bit)) & mask(checker.degree)
+}
+
+// Synced returns true if the Checker is synchronized to the pattern.
+func (checker *Checker) Synced() bool {
+	return checker.synced
+}
+
+// Bits returns the number of bits checked while synchronized.
+func (checker *Checker) Bits() uint64 {
+	return checker.bits
+}
+
+// Errors returns the number of bit errors counted while synchronized.
+func (checker *Checker) Errors() uint64 {
+	return checker.errors
+}
+
+// Reset clears the error counts, and forces the Checker to resynchronize.
+func (checker *Checker) Reset() {
+	*checker = Checker{
+		degree: checker.degree,
+		taps:   checker.taps,
+		invert: checker.invert,
+	}
+}
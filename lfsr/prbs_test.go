@@ -0,0 +1,106 @@
+package lfsr
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var patterns = []Pattern{PRBS7, PRBS9, PRBS11, PRBS15, PRBS23, PRBS31}
+
+func TestPattern_Period(t *testing.T) {
+	for _, pattern := range patterns {
+		expected := uint64(1)<<pattern.Poly.Degree() - 1
+		if period := pattern.NewGenerator().Period(); period != expected {
+			t.Errorf("expected %s to have period %d, got %d", pattern.Name, expected, period)
+		}
+	}
+}
+
+func TestPattern_Invert(t *testing.T) {
+	if word := PRBS7.NewGenerator().Word(32); word != 0xFE041851 {
+		t.Errorf("expected PRBS7 to begin with 0xFE041851, got 0x%X", word)
+	}
+	if word := PRBS7.Invert().NewGenerator().Word(32); word != ^uint64(0xFE041851)&0xFFFFFFFF {
+		t.Errorf("expected inverted PRBS7 to begin with 0x%X, got 0x%X", ^uint64(0xFE041851)&0xFFFFFFFF, word)
+	}
+
+	plain := PRBS31.Invert().NewGenerator()
+	inverted := PRBS31.NewGenerator()
+	for i := 0; i < 100; i++ {
+		if a, b := plain.Bit(), inverted.Bit(); a == b {
+			t.Fatalf("expected bit %d of PRBS31 to be inverted", i)
+		}
+	}
+}
+
+func TestChecker(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, pattern := range patterns {
+		gen := pattern.NewGenerator()
+		gen.Jump(uint64(rng.Int63n(1 << 20)))
+
+		checker := pattern.NewChecker()
+		for i := 0; i < 200; i++ {
+			checker.Bit(gen.Bit())
+		}
+		if !checker.Synced() {
+			t.Errorf("expected checker to sync to %s", pattern.Name)
+			continue
+		}
+		if errors := checker.Errors(); errors != 0 {
+			t.Errorf("expected no errors from clean %s stream, got %d", pattern.Name, errors)
+		}
+
+		injected := uint64(0)
+		for i := 0; i < 10000; i++ {
+			bit := gen.Bit()
+			if rng.Intn(500) == 0 {
+				bit ^= 1
+				injected++
+			}
+			if isError := checker.Bit(bit); isError && injected == 0 {
+				t.Fatalf("unexpected error reported by %s checker", pattern.Name)
+			}
+		}
+
+		if errors := checker.Errors(); errors != injected {
+			t.Errorf("expected %s checker to count %d errors, got %d", pattern.Name, injected, errors)
+		}
+		// The checker spends n bits loading its register, and n more confirming sync.
+		if bits, expected := checker.Bits(), 10200-2*checker.degree; bits != expected {
+			t.Errorf("expected %s checker to check %d bits, got %d", pattern.Name, expected, bits)
+		}
+	}
+}
+
+func TestChecker_LossOfSync(t *testing.T) {
+	gen := PRBS15.NewGenerator()
+	checker := PRBS15.NewChecker()
+
+	buf := make([]byte, 32)
+	gen.Read(buf)
+	checker.Write(buf)
+	if !checker.Synced() {
+		t.Fatalf("expected checker to sync to PRBS15")
+	}
+
+	// A PRBS9 stream should cause the PRBS15 checker to lose sync.
+	PRBS9.NewGenerator().Read(buf)
+	checker.Write(buf)
+	if checker.Synced() {
+		t.Errorf("expected checker to lose sync on the wrong pattern")
+	}
+
+	// The checker should then resynchronize once the right pattern resumes.
+	gen.Read(buf)
+	checker.Write(buf)
+	if !checker.Synced() {
+		t.Errorf("expected checker to resynchronize to PRBS15")
+	}
+
+	checker.Reset()
+	if checker.Synced() || checker.Errors() != 0 || checker.Bits() != 0 {
+		t.Errorf("expected Reset to clear checker state")
+	}
+}
@@ -0,0 +1,214 @@
+package galois
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+)
+
+// maxLogTableDegree is the largest degree of prime polynomial for which Log
+// uses a lookup table, rather than computing logarithms on demand.
+const maxLogTableDegree = 16
+
+// noLog marks elements in a log table which are not a power of the Generator.
+const noLog = math.MaxUint32
+
+// maxCachedLogTables is the largest number of tables held by logTables. Each
+// table takes up to 256KiB. Once the cache is full, Log computes logarithms in
+// fields over other primes without a table.
+const maxCachedLogTables = 64
+
+// logTables caches the tables of logarithms used by Log for small fields, so
+// that Fields over the same prime share one table.
+var logTables logTableCache
+
+// logTableCache holds tables of logarithms, keyed by prime polynomial, up to a
+// limit of maxCachedLogTables tables.
+type logTableCache struct {
+	entries sync.Map
+	size    atomic.Int64
+}
+
+// logTableEntry is a table of logarithms in a logTableCache, built on first use.
+type logTableEntry struct {
+	once  sync.Once
+	table []uint32
+}
+
+// table returns the table of logarithms for the field over the given prime,
+// which maps each element to its logarithm, or to noLog. The table is built on
+// first use. Returns false if the table is not cached, and the cache is full.
+func (cache *logTableCache) table(prime Polynomial) ([]uint32, bool) {
+	value, ok := cache.entries.Load(prime)
+	if !ok {
+		// Reserve space for the entry before storing it, so that concurrent
+		// callers cannot exceed the limit.
+		if cache.size.Add(1) > maxCachedLogTables {
+			cache.size.Add(-1)
+			return nil, false
+		}
+
+		var loaded bool
+		value, loaded = cache.entries.LoadOrStore(prime, new(logTableEntry))
+		if loaded {
+			cache.size.Add(-1)
+		}
+	}
+
+	entry := value.(*logTableEntry)
+	entry.once.Do(func() {
+		entry.table = buildLogTable(prime)
+	})
+	return entry.table, true
+}
+
+// ElementOrder returns the multiplicative order of the element a: the smallest
+// positive integer e such that a^e = 1. The order of every non-zero element
+// divides 2^m - 1. Returns zero if a is zero, which has no multiplicative order.
+func (field *Field[T]) ElementOrder(a T) uint64 {
+	field.check(a)
+	if a == 0 {
+		return 0
+	}
+
+	order := field.Order() - 1
+	for _, q := range primeFactors(order) {
+		for order%q == 0 && field.Exp(a, order/q) == 1 {
+			order /= q
+		}
+	}
+	return order
+}
+
+// IsPrimitiveElement returns true if a generates every non-zero element of the
+// field by exponentiation, which is to say its order is 2^m - 1.
+func (field *Field[T]) IsPrimitiveElement(a T) bool {
+	return a != 0 && field.ElementOrder(a) == field.Order()-1
+}
+
+// Log returns the discrete logarithm of the element a with respect to the
+// Generator element, which is the smallest exponent e such that Generate(e) = a.
+//
+// For fields of degree 16 or less, Log uses a table of every element's logarithm,
+// which is built on first use, and shared by every Field with the same prime
+// polynomial. Tables are cached for up to 64 distinct primes. For larger fields,
+// or once the cache is full, Log uses the Pohlig-Hellman algorithm, which splits
+// the problem into logarithms in subgroups of prime order q dividing 2^m - 1,
+// which are each solved in time proportional to the square root of q using
+// baby-step giant-step.
+//
+// Returns an error wrapping ErrDivisionByZero if a is zero, because zero has no
+// logarithm, an error wrapping ErrNotIrreducible if the field's prime polynomial
+// is not irreducible, or an error wrapping ErrNoLogarithm if a is not a power of
+// the Generator, which can only happen if the field's prime polynomial is not
+// primitive.
+func (field *Field[T]) Log(a T) (uint64, error) {
+	field.check(a)
+	if a == 0 {
+		return 0, fmt.Errorf("%w: cannot take logarithm of zero", ErrDivisionByZero)
+	}
+
+	if !irreducibleCache.test(field.Prime, Polynomial.IsIrreducible) {
+		return 0, fmt.Errorf("%w: cannot take logarithms modulo %s", ErrNotIrreducible, field.Prime)
+	}
+
+	if field.Prime.Degree() <= maxLogTableDegree {
+		if table, ok := logTables.table(field.Prime); ok {
+			if uint64(a) >= uint64(len(table)) || table[a] == noLog {
+				return 0, fmt.Errorf("%w: %d is not a power of the generator in GF(2^%d)", ErrNoLogarithm, a, field.Prime.Degree())
+			}
+			return uint64(table[a]), nil
+		}
+	}
+
+	generator := field.Generate(1)
+	order := field.ElementOrder(generator)
+	if field.Exp(a, order) != 1 {
+		return 0, fmt.Errorf("%w: %d is not a power of the generator in GF(2^%d)", ErrNoLogarithm, a, field.Prime.Degree())
+	}
+
+	// Solve for the logarithm modulo each prime power q^e dividing the order of
+	// the generator, and combine them with the chinese remainder theorem.
+	var (
+		result  uint64
+		modulus uint64 = 1
+	)
+	for _, q := range primeFactors(order) {
+		qPow := uint64(1)
+		for order%(qPow*q) == 0 {
+			qPow *= q
+		}
+
+		residue := field.logPrimePower(a, generator, order, q, qPow)
+
+		// Find x = result mod modulus, x = residue mod qPow.
+		_, inv := bezout(modulus%qPow, qPow)
+		diff := (residue + qPow - result%qPow) % qPow
+		result += modulus * ((diff * inv) % qPow)
+		modulus *= qPow
+	}
+
+	return result, nil
+}
+
+// logPrimePower returns the logarithm of a to the given generator, modulo the
+// prime power qPow = q^e which divides the order of the generator.
+func (field *Field[T]) logPrimePower(a, generator T, order, q, qPow uint64) uint64 {
+	// gamma has order q.
+	gamma := field.Exp(generator, order/q)
+
+	var x uint64
+	for qk := uint64(1); qk < qPow; qk *= q {
+		// h = (g^-x * a)^(order / q^(k+1)) has order dividing q.
+		h := field.Div(a, field.Exp(generator, x))
+		h = field.Exp(h, order/(qk*q))
+		x += qk * field.babyStepGiantStep(h, gamma, q)
+	}
+	return x
+}
+
+// babyStepGiantStep returns the logarithm of h to the base gamma, where gamma
+// has prime order q and h is a power of gamma.
+func (field *Field[T]) babyStepGiantStep(h, gamma T, q uint64) uint64 {
+	steps := uint64(math.Ceil(math.Sqrt(float64(q))))
+
+	babySteps := make(map[T]uint64, steps)
+	power := T(1)
+	for j := uint64(0); j < steps; j++ {
+		babySteps[power] = j
+		power = field.Mul(power, gamma)
+	}
+
+	// giantStep = gamma^-steps
+	giantStep := field.MultInverse(power)
+	for i := uint64(0); i <= steps; i++ {
+		if j, ok := babySteps[h]; ok {
+			return (i*steps + j) % q
+		}
+		h = field.Mul(h, giantStep)
+	}
+
+	panic(fmt.Sprintf("failed to find logarithm of %d to base %d", h, gamma))
+}
+
+// buildLogTable computes the table of logarithms of every element of the field
+// over the given prime.
+func buildLogTable(prime Polynomial) []uint32 {
+	order := fieldOrder(prime)
+	table := make([]uint32, order)
+	for i := range table {
+		table[i] = noLog
+	}
+
+	generator := Generator.Mod(prime)
+	power := Polynomial(1)
+	for e := uint64(0); e < order-1; e++ {
+		if table[power] != noLog {
+			break
+		}
+		table[power] = uint32(e)
+		power = power.Mul(generator).Mod(prime)
+	}
+	return table
+}
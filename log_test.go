@@ -0,0 +1,157 @@
+package galois
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestField_Log_Table(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+
+	for e := uint64(0); e < 255; e++ {
+		log, err := field.Log(field.Generate(e))
+		if err != nil {
+			t.Fatalf("failed to take logarithm of %d: %s", field.Generate(e), err)
+		}
+		if log != e {
+			t.Errorf("expected log(%d) = %d, got %d", field.Generate(e), e, log)
+		}
+	}
+
+	if _, err := field.Log(0); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("expected ErrDivisionByZero taking logarithm of zero, got %v", err)
+	}
+}
+
+func TestField_Log_TablePerPrime(t *testing.T) {
+	// Log tables are cached by prime, and shared by Fields of any type.
+	fields := []*Field[uint16]{
+		NewField[uint16](PrimePolynomialDegree8),
+		NewField[uint16](0x11B),
+		NewField[uint16](PrimePolynomialDegree8),
+	}
+	if *fields[0] != *fields[2] {
+		t.Errorf("expected Fields over the same prime to be equal")
+	}
+
+	// x has order 51 in the AES field, and order 255 in the other.
+	for _, field := range fields {
+		for e := uint64(0); e < 51; e++ {
+			if log, err := field.Log(field.Generate(e)); err != nil || log != e {
+				t.Errorf("expected log(x^%d) = %d over %s, got %d, %v", e, e, field.Prime, log, err)
+			}
+		}
+	}
+}
+
+func TestField_Log_Reducible(t *testing.T) {
+	// x^8 + 1 = (x + 1)^8.
+	field := &Field[uint8]{Prime: 0x101}
+	if _, err := field.Log(2); !errors.Is(err, ErrNotIrreducible) {
+		t.Errorf("expected ErrNotIrreducible taking logarithm modulo reducible prime, got %v", err)
+	}
+}
+
+func TestLogTableCache_Limit(t *testing.T) {
+	var cache logTableCache
+
+	var cached int
+	for prime := Polynomial(2); prime < 2+maxCachedLogTables+10; prime++ {
+		if _, ok := cache.table(prime); ok {
+			cached++
+		}
+	}
+	if cached != maxCachedLogTables || cache.size.Load() != maxCachedLogTables {
+		t.Errorf("expected cache to hold %d tables, got %d", maxCachedLogTables, cache.size.Load())
+	}
+
+	// Tables already in the cache are still returned once it is full.
+	if table, ok := cache.table(2); !ok || table[1] != 0 {
+		t.Errorf("expected cached table for prime 2")
+	}
+}
+
+func TestField_Log_PohligHellman(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	primes := []Polynomial{
+		PrimePolynomialDegree17,
+		PrimePolynomialDegree20,
+		PrimePolynomialDegree24,
+		PrimePolynomialDegree29,
+		PrimePolynomialDegree31,
+		PrimePolynomialDegree32,
+	}
+
+	for _, prime := range primes {
+		field := NewField[uint32](prime)
+		for trial := 0; trial < 10; trial++ {
+			e := uint64(rng.Int63n(int64(field.Order() - 1)))
+			a := field.Generate(e)
+
+			log, err := field.Log(a)
+			if err != nil {
+				t.Fatalf("failed to take logarithm of %d in GF(2^%d): %s", a, prime.Degree(), err)
+			}
+			if log != e {
+				t.Errorf("expected log(%d) = %d in GF(2^%d), got %d", a, e, prime.Degree(), log)
+			}
+		}
+	}
+}
+
+func TestField_Log_NonPrimitive(t *testing.T) {
+	// In the AES field, x has order 51, while x + 1 is primitive. Similarly,
+	// x^20 + x^3 + x^2 + x + 1 is irreducible, but x has order 349525 = (2^20 - 1) / 3.
+	for _, prime := range []Polynomial{0x11B, 0x10000F} {
+		field := NewField[uint32](prime)
+
+		if log, err := field.Log(field.Generate(10)); err != nil || log != 10 {
+			t.Errorf("expected log(x^10) = 10 in GF(2^%d), got %d, %v", prime.Degree(), log, err)
+		}
+
+		var outside uint32
+		for a := uint32(2); outside == 0; a++ {
+			if field.IsPrimitiveElement(a) {
+				outside = a
+			}
+		}
+		if _, err := field.Log(outside); !errors.Is(err, ErrNoLogarithm) {
+			t.Errorf("expected ErrNoLogarithm taking logarithm of primitive element %d in GF(2^%d), got %v", outside, prime.Degree(), err)
+		}
+	}
+}
+
+func TestField_ElementOrder(t *testing.T) {
+	aes := NewField[uint8](0x11B)
+
+	type TestCase struct {
+		Element uint8
+		Order   uint64
+	}
+
+	testCases := []TestCase{
+		{Element: 0, Order: 0},
+		{Element: 1, Order: 1},
+		{Element: 2, Order: 51},
+		{Element: 3, Order: 255},
+	}
+
+	for _, test := range testCases {
+		if order := aes.ElementOrder(test.Element); order != test.Order {
+			t.Errorf("expected order of %d to be %d, got %d", test.Element, test.Order, order)
+		}
+		if primitive := aes.IsPrimitiveElement(test.Element); primitive != (test.Order == 255) {
+			t.Errorf("expected %d primitive = %v", test.Element, test.Order == 255)
+		}
+	}
+
+	field := NewField[uint32](PrimePolynomialDegree32)
+	if !field.IsPrimitiveElement(field.Generate(1)) {
+		t.Errorf("expected generator to be primitive in GF(2^32)")
+	}
+	if order := field.ElementOrder(field.Generate(3 * 5 * 17)); order != (1<<32-1)/(3*5*17) {
+		t.Errorf("expected order of x^255 to be %d, got %d", (1<<32-1)/(3*5*17), order)
+	}
+}
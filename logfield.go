@@ -0,0 +1,194 @@
+package galois
+
+import "fmt"
+
+// maxZechTableDegree is the largest degree of prime polynomial for which a
+// LogField can be constructed, limiting the size of its Zech logarithm table.
+const maxZechTableDegree = 20
+
+// LogField is a finite field whose elements are represented by their discrete
+// logarithms, as exponents of the Generator element, rather than as polynomials.
+//
+// In this representation, multiplication and division are simply addition and
+// subtraction of exponents modulo 2^m - 1. Addition instead requires a lookup in
+// a table of Zech logarithms: Z(n) is the exponent satisfying
+//
+//	1 + x^n = x^Z(n)
+//
+// so that x^a + x^b = x^(a + Z(b - a)).
+//
+// Exponents range from 0 to 2^m - 2. The zero element has no logarithm, and is
+// represented by the value 2^m - 1, which is returned by Zero. The multiplicative
+// identity 1 is represented by the exponent 0.
+type LogField[T IntLike] struct {
+	field  *Field[T]
+	zero   T
+	zech   []T
+	logs   []T
+	powers []T
+}
+
+// NewLogField creates a LogField generated by the given prime polynomial. The
+// type parameter T selects the type used to represent exponents, and must be
+// large enough to represent every element of the field, as with NewField.
+//
+// Panics if prime is not primitive, because then its Generator element cannot
+// produce every non-zero element, or if its degree is greater than 20.
+func NewLogField[T IntLike](prime Polynomial) *LogField[T] {
+	if prime.Degree() > maxZechTableDegree {
+		panic(fmt.Sprintf("cannot construct LogField of GF(2^%d); max degree is %d", prime.Degree(), maxZechTableDegree))
+	}
+	if !prime.IsPrimitive() {
+		panic(fmt.Sprintf("cannot construct LogField with non-primitive polynomial %s", prime))
+	}
+
+	field := NewField[T](prime)
+	groupOrder := field.Order() - 1
+
+	powers := make([]T, groupOrder)
+	logs := make([]T, field.Order())
+	logs[0] = T(groupOrder)
+	power := Polynomial(1)
+	for e := uint64(0); e < groupOrder; e++ {
+		powers[e] = T(power)
+		logs[power] = T(e)
+		power = power.Mul(Generator).Mod(prime)
+	}
+
+	zech := make([]T, groupOrder)
+	for n, power := range powers {
+		zech[n] = logs[power^1]
+	}
+
+	return &LogField[T]{
+		field:  field,
+		zero:   T(groupOrder),
+		zech:   zech,
+		logs:   logs,
+		powers: powers,
+	}
+}
+
+// Field returns the polynomial-basis Field which this LogField represents.
+func (lf *LogField[T]) Field() *Field[T] {
+	return lf.field
+}
+
+// Zero returns the representation of the additive identity element zero.
+func (lf *LogField[T]) Zero() T {
+	return lf.zero
+}
+
+// One returns the representation of the multiplicative identity element 1, which
+// is the exponent zero.
+func (lf *LogField[T]) One() T {
+	return 0
+}
+
+// FromField converts a polynomial-basis element of the Field to its logarithm.
+func (lf *LogField[T]) FromField(a T) T {
+	return lf.logs[a]
+}
+
+// ToField converts a logarithm back to a polynomial-basis element of the Field.
+func (lf *LogField[T]) ToField(e T) T {
+	if e == lf.zero {
+		return 0
+	}
+	return lf.powers[e]
+}
+
+// Zech returns the Zech logarithm Z(n), such that 1 + x^n = x^Z(n). Z(0) is
+// Zero, because 1 + 1 = 0.
+func (lf *LogField[T]) Zech(n T) T {
+	return lf.zech[n]
+}
+
+// Add computes the sum of the given elements, using the Zech logarithm table.
+func (lf *LogField[T]) Add(values ...T) T {
+	sum := lf.zero
+	for _, v := range values {
+		if v == lf.zero {
+			continue
+		} else if sum == lf.zero {
+			sum = v
+			continue
+		}
+
+		// x^a + x^b = x^a * (1 + x^(b-a)) = x^(a + Z(b-a))
+		z := lf.zech[lf.sub(v, sum)]
+		if z == lf.zero {
+			sum = lf.zero
+		} else {
+			sum = lf.add(sum, z)
+		}
+	}
+	return sum
+}
+
+// Sub computes the difference of the given elements (a - b), which is the same
+// as their sum.
+func (lf *LogField[T]) Sub(a, b T) T {
+	return lf.Add(a, b)
+}
+
+// Mul computes the product of the given elements, by adding their exponents. If
+// called with no parameters, Mul returns Zero, as with Field.Mul.
+func (lf *LogField[T]) Mul(values ...T) T {
+	if len(values) == 0 {
+		return lf.zero
+	}
+	product := T(0)
+	for _, v := range values {
+		if v == lf.zero {
+			return lf.zero
+		}
+		product = lf.add(product, v)
+	}
+	return product
+}
+
+// MultInverse computes the multiplicative inverse of a by negating its exponent.
+//
+// Panics if a is Zero.
+func (lf *LogField[T]) MultInverse(a T) T {
+	if a == lf.zero {
+		panic("division by zero error")
+	}
+	return lf.sub(0, a)
+}
+
+// Div computes the division of numerator by denominator, by subtracting their
+// exponents.
+//
+// Panics if denominator is Zero.
+func (lf *LogField[T]) Div(numerator, denominator T) T {
+	if denominator == lf.zero {
+		panic("division by zero error")
+	} else if numerator == lf.zero {
+		return lf.zero
+	}
+	return lf.sub(numerator, denominator)
+}
+
+// Exp raises the base element to the given power, by multiplying its exponent.
+//
+// If exponent is zero, returns One.
+func (lf *LogField[T]) Exp(base T, exponent uint64) T {
+	if exponent == 0 {
+		return 0
+	} else if base == lf.zero {
+		return lf.zero
+	}
+	return T((uint64(base) * (exponent % uint64(lf.zero))) % uint64(lf.zero))
+}
+
+// add adds two exponents modulo 2^m - 1.
+func (lf *LogField[T]) add(a, b T) T {
+	return T((uint64(a) + uint64(b)) % uint64(lf.zero))
+}
+
+// sub subtracts two exponents modulo 2^m - 1.
+func (lf *LogField[T]) sub(a, b T) T {
+	return T((uint64(a) + uint64(lf.zero) - uint64(b)) % uint64(lf.zero))
+}
@@ -0,0 +1,121 @@
+package galois
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestLogField_Conversion(t *testing.T) {
+	lf := NewLogField[uint16](PrimePolynomialDegree10)
+	field := lf.Field()
+
+	for a := uint16(0); uint64(a) < field.Order(); a++ {
+		e := lf.FromField(a)
+		if back := lf.ToField(e); back != a {
+			t.Errorf("expected element %d to survive conversion, got %d", a, back)
+		}
+		if a != 0 && field.Generate(uint64(e)) != a {
+			t.Errorf("expected exponent %d to generate %d", e, a)
+		}
+	}
+
+	if lf.FromField(0) != lf.Zero() || lf.FromField(1) != lf.One() {
+		t.Errorf("expected identity elements to convert to Zero and One")
+	}
+}
+
+func TestLogField_MatchesField(t *testing.T) {
+	lf := NewLogField[uint8](PrimePolynomialDegree6)
+	field := lf.Field()
+
+	for a := uint8(0); uint64(a) < field.Order(); a++ {
+		for b := uint8(0); uint64(b) < field.Order(); b++ {
+			la, lb := lf.FromField(a), lf.FromField(b)
+
+			if sum := lf.ToField(lf.Add(la, lb)); sum != field.Add(a, b) {
+				t.Errorf("expected %d + %d = %d, got %d", a, b, field.Add(a, b), sum)
+			}
+			if product := lf.ToField(lf.Mul(la, lb)); product != field.Mul(a, b) {
+				t.Errorf("expected %d * %d = %d, got %d", a, b, field.Mul(a, b), product)
+			}
+			if b != 0 {
+				if quotient := lf.ToField(lf.Div(la, lb)); quotient != field.Div(a, b) {
+					t.Errorf("expected %d / %d = %d, got %d", a, b, field.Div(a, b), quotient)
+				}
+			}
+		}
+	}
+}
+
+func TestLogField_Algebra(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	lf := NewLogField[uint16](PrimePolynomialDegree12)
+	order := int(lf.Field().Order())
+
+	// Element representations range over [0, 2^m - 1], including Zero.
+	random := func() uint16 { return uint16(rng.Intn(order)) }
+
+	for trial := 0; trial < 2000; trial++ {
+		a, b, c := random(), random(), random()
+
+		if lf.Add(a, b) != lf.Add(b, a) || lf.Mul(a, b) != lf.Mul(b, a) {
+			t.Errorf("expected addition and multiplication of %d and %d to commute", a, b)
+		}
+		if lf.Add(lf.Add(a, b), c) != lf.Add(a, lf.Add(b, c)) {
+			t.Errorf("expected addition of %d, %d, %d to associate", a, b, c)
+		}
+		if lf.Mul(a, lf.Add(b, c)) != lf.Add(lf.Mul(a, b), lf.Mul(a, c)) {
+			t.Errorf("failed distributivity test: %d(%d + %d)", a, b, c)
+		}
+		if lf.Add(a, a) != lf.Zero() || lf.Add(a, lf.Zero()) != a {
+			t.Errorf("expected %d to be its own additive inverse", a)
+		}
+		if lf.Exp(a, 3) != lf.Mul(a, a, a) {
+			t.Errorf("expected %d^3 = %d * %d * %d", a, a, a, a)
+		}
+	}
+
+	seen := make(map[uint16]bool)
+	for a := uint16(0); a < lf.Zero(); a++ {
+		inverse := lf.MultInverse(a)
+		if seen[inverse] {
+			t.Errorf("inverse %d of %d is not unique", inverse, a)
+		}
+		seen[inverse] = true
+
+		if product := lf.Mul(a, inverse); product != lf.One() {
+			t.Errorf("expected %d * %d = One, got %d", a, inverse, product)
+		}
+	}
+}
+
+func TestLogField_Zech(t *testing.T) {
+	lf := NewLogField[uint8](PrimePolynomialDegree4)
+	field := lf.Field()
+
+	if lf.Zech(0) != lf.Zero() {
+		t.Errorf("expected Z(0) to be Zero")
+	}
+	for n := uint8(1); n < lf.Zero(); n++ {
+		expected := field.Add(1, field.Generate(uint64(n)))
+		if actual := field.Generate(uint64(lf.Zech(n))); actual != expected {
+			t.Errorf("expected x^Z(%d) = 1 + x^%d = %d, got %d", n, n, expected, actual)
+		}
+	}
+}
+
+func BenchmarkLogField_Mul_16(b *testing.B) {
+	lf := NewLogField[uint16](PrimePolynomialDegree16)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lf.Mul(0x1234, 0x5678)
+	}
+}
+
+func BenchmarkLogField_Add_16(b *testing.B) {
+	lf := NewLogField[uint16](PrimePolynomialDegree16)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lf.Add(0x1234, 0x5678)
+	}
+}
@@ -0,0 +1,86 @@
+package galois
+
+// bitMatrix is a matrix over GF(2) with at most 64 rows, stored as a slice of
+// columns. Bit i of column j is the entry in row i and column j, so that column j
+// is the image of the j-th unit vector under the linear map the matrix represents.
+type bitMatrix []uint64
+
+// mulVec multiplies the matrix by the column vector v, whose bit j is the j-th
+// coordinate.
+func (m bitMatrix) mulVec(v uint64) (result uint64) {
+	for j, column := range m {
+		if (v>>j)&1 == 1 {
+			result ^= column
+		}
+	}
+	return
+}
+
+// bitEchelon is the row-reduced form of the columns of a bitMatrix, used to
+// solve linear systems m*x = v repeatedly without reducing m each time.
+type bitEchelon struct {
+	// pivots[i] is a combination of the columns of m, whose lowest set bit is the
+	// pivot bit pivotBits[i]. combos[i] records which columns were combined.
+	pivots    []uint64
+	pivotBits []uint64
+	combos    []uint64
+}
+
+// echelon row-reduces the columns of m. Returns false if the columns are not
+// linearly independent.
+func (m bitMatrix) echelon() (*bitEchelon, bool) {
+	e := &bitEchelon{
+		pivots:    make([]uint64, 0, len(m)),
+		pivotBits: make([]uint64, 0, len(m)),
+		combos:    make([]uint64, 0, len(m)),
+	}
+
+	for j, column := range m {
+		vec, combo := e.reduce(column, 1<<j)
+		if vec == 0 {
+			return nil, false
+		}
+		e.pivots = append(e.pivots, vec)
+		e.pivotBits = append(e.pivotBits, vec&-vec)
+		e.combos = append(e.combos, combo)
+	}
+	return e, true
+}
+
+// reduce eliminates every pivot bit from vec, accumulating the combinations of
+// columns used into combo.
+func (e *bitEchelon) reduce(vec, combo uint64) (uint64, uint64) {
+	for i, pivot := range e.pivots {
+		if vec&e.pivotBits[i] != 0 {
+			vec ^= pivot
+			combo ^= e.combos[i]
+		}
+	}
+	return vec, combo
+}
+
+// solve returns the vector x such that m*x = v. Returns false if v is not in the
+// span of the columns of m.
+func (e *bitEchelon) solve(v uint64) (uint64, bool) {
+	rest, x := e.reduce(v, 0)
+	return x, rest == 0
+}
+
+// inverse returns the inverse of the square n-by-n matrix m. Returns false if m
+// is singular.
+func (m bitMatrix) inverse() (bitMatrix, bool) {
+	e, ok := m.echelon()
+	if !ok {
+		return nil, false
+	}
+
+	inv := make(bitMatrix, len(m))
+	for j := range inv {
+		column, ok := e.solve(1 << j)
+		if !ok {
+			return nil, false
+		}
+		inv[j] = column
+	}
+	return inv, true
+}
@@ -0,0 +1,49 @@
+package galois
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBitMatrix_Inverse(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for trial := 0; trial < 100; trial++ {
+		n := 1 + rng.Intn(32)
+		m := make(bitMatrix, n)
+		for j := range m {
+			m[j] = rng.Uint64() & (1<<n - 1)
+		}
+
+		inv, ok := m.inverse()
+		if !ok {
+			continue
+		}
+		for v := uint64(0); v < 64; v++ {
+			x := rng.Uint64() & (1<<n - 1)
+			if back := inv.mulVec(m.mulVec(x)); back != x {
+				t.Fatalf("expected inverse to undo matrix; %x became %x", x, back)
+			}
+		}
+	}
+
+	singular := bitMatrix{0b011, 0b110, 0b101}
+	if _, ok := singular.inverse(); ok {
+		t.Errorf("expected singular matrix to have no inverse")
+	}
+}
+
+func TestBitEchelon_Solve(t *testing.T) {
+	m := bitMatrix{0b0011, 0b0110}
+	e, ok := m.echelon()
+	if !ok {
+		t.Fatalf("expected independent columns")
+	}
+
+	if x, ok := e.solve(0b0101); !ok || x != 0b11 {
+		t.Errorf("expected solution 0b11, got %b (%v)", x, ok)
+	}
+	if _, ok := e.solve(0b1000); ok {
+		t.Errorf("expected vector outside span to have no solution")
+	}
+}
@@ -0,0 +1,71 @@
+package galois
+
+import "fmt"
+
+// MinimalPolynomial returns the minimal polynomial of the element a: the binary
+// Polynomial of least degree which has a as a root. It is the product of (x - c)
+// over every conjugate c of a, and its degree is the number of conjugates.
+//
+// The minimal polynomial of every element is irreducible, and the minimal
+// polynomial of a primitive element is a primitive polynomial. In particular,
+// the minimal polynomial of the Generator element x is the field's prime.
+func (field *Field[T]) MinimalPolynomial(a T) Polynomial {
+	field.check(a)
+	coeffs := FieldPolynomial[T]{1}
+	for _, c := range field.Conjugates(a) {
+		// Multiply by (x + c).
+		next := make(FieldPolynomial[T], len(coeffs)+1)
+		for i, coeff := range coeffs {
+			next[i+1] = field.Add(next[i+1], coeff)
+			next[i] = field.Add(next[i], field.Mul(c, coeff))
+		}
+		coeffs = next
+	}
+
+	var poly Polynomial
+	for i, coeff := range coeffs {
+		if coeff > 1 {
+			panic(
+				fmt.Sprintf(
+					"minimal polynomial of %d has coefficient %d outside GF(2); is %s irreducible?",
+					a, coeff, field.Prime,
+				),
+			)
+		}
+		poly |= Polynomial(coeff) << i
+	}
+	return poly
+}
+
+// CyclotomicCosets returns the cyclotomic cosets of two modulo 2^m - 1. Each
+// coset is the set {s, 2s, 4s, ...} of exponents modulo 2^m - 1, listed in that
+// order, and the cosets are ordered by their smallest element s.
+//
+// The cosets partition the exponents of the non-zero elements of GF(2^m): if
+// g is a primitive element, then the conjugates of g^s are exactly the powers of
+// g whose exponents are in the coset of s, and they share the same minimal
+// polynomial.
+//
+// This takes time and memory proportional to 2^m.
+func CyclotomicCosets(m uint64) [][]uint64 {
+	if m == 0 || m > 32 {
+		panic(fmt.Sprintf("cannot compute cyclotomic cosets for GF(2^%d)", m))
+	}
+
+	n := uint64(1)<<m - 1
+	seen := make([]bool, n)
+
+	var cosets [][]uint64
+	for s := uint64(0); s < n; s++ {
+		if seen[s] {
+			continue
+		}
+		var coset []uint64
+		for e := s; !seen[e]; e = (2 * e) % n {
+			seen[e] = true
+			coset = append(coset, e)
+		}
+		cosets = append(cosets, coset)
+	}
+	return cosets
+}
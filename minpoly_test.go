@@ -0,0 +1,97 @@
+package galois
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestField_MinimalPolynomial(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree4)
+
+	type TestCase struct {
+		Element  uint8
+		Expected Polynomial
+	}
+
+	testCases := []TestCase{
+		{Element: 0, Expected: 0b10},
+		{Element: 1, Expected: 0b11},
+		{Element: field.Generate(1), Expected: PrimePolynomialDegree4},
+		{Element: field.Generate(2), Expected: PrimePolynomialDegree4},
+		{Element: field.Generate(3), Expected: 0b11111},
+		{Element: field.Generate(5), Expected: 0b111},
+		{Element: field.Generate(7), Expected: 0b11001},
+	}
+
+	for _, test := range testCases {
+		if actual := field.MinimalPolynomial(test.Element); actual != test.Expected {
+			t.Errorf("expected minimal polynomial of %d to be %s, got %s", test.Element, test.Expected, actual)
+		}
+	}
+}
+
+func TestField_MinimalPolynomial_Roots(t *testing.T) {
+	primes := []Polynomial{
+		PrimePolynomialDegree5,
+		PrimePolynomialDegree8,
+		PrimePolynomialDegree10,
+	}
+
+	for _, prime := range primes {
+		field := NewField[uint16](prime)
+
+		// The minimal polynomials of each coset multiply to x^(2^m - 1) - 1.
+		product := FieldPolynomial[uint16]{1}
+		for _, coset := range CyclotomicCosets(prime.Degree()) {
+			element := field.Generate(coset[0])
+			minPoly := field.MinimalPolynomial(element)
+
+			if minPoly.Degree() != uint64(len(coset)) || !minPoly.IsIrreducible() {
+				t.Errorf("invalid minimal polynomial %s for coset %v", minPoly, coset)
+			}
+
+			for _, e := range coset {
+				root := field.Generate(e)
+				if field.EvalPolynomial(binaryFieldPolynomial[uint16](minPoly), root) != 0 {
+					t.Errorf("expected %d to be a root of %s", root, minPoly)
+				}
+			}
+
+			product = field.mulPolynomials(product, binaryFieldPolynomial[uint16](minPoly))
+		}
+
+		expected := make(FieldPolynomial[uint16], field.Order())
+		expected[0] = 1
+		expected[len(expected)-1] = 1
+		if !reflect.DeepEqual(product, expected) {
+			t.Errorf("expected minimal polynomials of GF(2^%d) to multiply to x^%d - 1", prime.Degree(), field.Order()-1)
+		}
+	}
+}
+
+func TestCyclotomicCosets(t *testing.T) {
+	expected := [][]uint64{
+		{0},
+		{1, 2, 4, 8},
+		{3, 6, 12, 9},
+		{5, 10},
+		{7, 14, 13, 11},
+	}
+
+	if cosets := CyclotomicCosets(4); !reflect.DeepEqual(cosets, expected) {
+		t.Errorf("expected cyclotomic cosets %v, got %v", expected, cosets)
+	}
+
+	for m := uint64(1); m <= 12; m++ {
+		total := 0
+		for _, coset := range CyclotomicCosets(m) {
+			if m%uint64(len(coset)) != 0 {
+				t.Errorf("coset %v size does not divide %d", coset, m)
+			}
+			total += len(coset)
+		}
+		if total != 1<<m-1 {
+			t.Errorf("expected cosets for m = %d to cover %d exponents, got %d", m, 1<<m-1, total)
+		}
+	}
+}
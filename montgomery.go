@@ -0,0 +1,144 @@
+package galois
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// MontgomeryDomain performs multiplication in a Field using the Montgomery
+// representation of its elements. An element a is represented in the domain as
+// a*R mod P, where P is the field's prime polynomial and R = x^m. The Montgomery
+// product of two elements in the domain is a*b*R^-1 mod P, which keeps the result
+// in the domain, and can be computed without dividing by P.
+//
+// Multiplication uses the bit-serial algorithm of Koç and Acar: for each
+// coefficient of b, from lowest to highest degree, add a to the accumulator if
+// the coefficient is set, then add P if the accumulator's constant term is set,
+// and divide by x. Each step is a shift and up to two additions, whereas the
+// long division performed by Polynomial.Mod must locate the leading term of the
+// remainder at every step.
+//
+// Convert elements into the domain with ToMontgomery, perform any number of
+// operations, and convert the results back with FromMontgomery. Addition is the
+// same in and out of the domain.
+type MontgomeryDomain[T IntLike] struct {
+	field  *Field[T]
+	prime  uint64
+	degree uint64
+
+	// r2 and r3 are R^2 and R^3 modulo P, in the standard representation.
+	r2 uint64
+	r3 uint64
+}
+
+// Montgomery returns a MontgomeryDomain for arithmetic in this field.
+//
+// Panics if the field's prime polynomial has no constant term, in which case
+// x^m has no inverse modulo the prime, or if its degree is zero.
+func (field *Field[T]) Montgomery() *MontgomeryDomain[T] {
+	if field.Prime&1 == 0 || field.Prime.Degree() == 0 {
+		panic(fmt.Sprintf("cannot use Montgomery multiplication modulo %s", field.Prime))
+	}
+
+	m := field.Prime.Degree()
+	r := (Polynomial(1) << m).Mod(field.Prime)
+	r2 := r.Mul(r).Mod(field.Prime)
+	r3 := r2.Mul(r).Mod(field.Prime)
+
+	return &MontgomeryDomain[T]{
+		field:  field,
+		prime:  uint64(field.Prime),
+		degree: m,
+		r2:     uint64(r2),
+		r3:     uint64(r3),
+	}
+}
+
+// Field returns the Field whose elements the domain represents.
+func (md *MontgomeryDomain[T]) Field() *Field[T] {
+	return md.field
+}
+
+// montMul returns a*b*x^-m mod P.
+func (md *MontgomeryDomain[T]) montMul(a, b uint64) uint64 {
+	var c uint64
+	for i := uint64(0); i < md.degree; i++ {
+		c ^= a & -((b >> i) & 1)
+		c ^= md.prime & -(c & 1)
+		c >>= 1
+	}
+	return c
+}
+
+// ToMontgomery converts the field element a into the Montgomery domain, as a*R mod P.
+func (md *MontgomeryDomain[T]) ToMontgomery(a T) T {
+	return T(md.montMul(uint64(a), md.r2))
+}
+
+// FromMontgomery converts the element a in the Montgomery domain back into the
+// standard representation of the Field.
+func (md *MontgomeryDomain[T]) FromMontgomery(a T) T {
+	return T(md.montMul(uint64(a), 1))
+}
+
+// One returns the multiplicative identity in the Montgomery domain, which is R mod P.
+func (md *MontgomeryDomain[T]) One() T {
+	return T(md.montMul(1, md.r2))
+}
+
+// Add computes the sum of the given elements. Addition is the same in and out
+// of the Montgomery domain.
+func (md *MontgomeryDomain[T]) Add(values ...T) T {
+	return md.field.Add(values...)
+}
+
+// Sub computes the difference of the given elements (a - b), which is the same as
+// their sum.
+func (md *MontgomeryDomain[T]) Sub(a, b T) T {
+	return md.field.Sub(a, b)
+}
+
+// Mul multiplies a set of elements in the Montgomery domain and returns their
+// product, also in the domain. If called with no parameters, Mul returns zero.
+func (md *MontgomeryDomain[T]) Mul(values ...T) T {
+	if len(values) == 0 {
+		return 0
+	}
+	product := uint64(values[0])
+	for _, v := range values[1:] {
+		product = md.montMul(product, uint64(v))
+	}
+	return T(product)
+}
+
+// Exp multiplies the base element, in the Montgomery domain, by itself the given
+// number of times, and returns the result in the domain.
+//
+// If exponent is zero, returns the multiplicative identity One.
+func (md *MontgomeryDomain[T]) Exp(base T, exponent uint64) T {
+	result := uint64(md.One())
+	for i := bits.Len64(exponent) - 1; i >= 0; i-- {
+		result = md.montMul(result, result)
+		if (exponent>>i)&1 == 1 {
+			result = md.montMul(result, uint64(base))
+		}
+	}
+	return T(result)
+}
+
+// MultInverse computes the multiplicative inverse of the element a in the
+// Montgomery domain. If a represents y, it is y*R, whose inverse in the Field is
+// y^-1 * R^-1, and the result y^-1 * R is its Montgomery product with R^3.
+//
+// Panics if a is the additive identity element zero.
+func (md *MontgomeryDomain[T]) MultInverse(a T) T {
+	return T(md.montMul(uint64(md.field.MultInverse(a)), md.r3))
+}
+
+// Div returns the division of the numerator element by the denominator element,
+// both in the Montgomery domain.
+//
+// Panics if denominator is the additive identity element zero.
+func (md *MontgomeryDomain[T]) Div(numerator, denominator T) T {
+	return T(md.montMul(uint64(numerator), uint64(md.MultInverse(denominator))))
+}
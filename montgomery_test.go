@@ -0,0 +1,115 @@
+package galois
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestMontgomeryDomain_MatchesField(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+	md := field.Montgomery()
+
+	if md.FromMontgomery(md.One()) != 1 {
+		t.Errorf("expected One to convert to the multiplicative identity")
+	}
+
+	for a := 0; a < 256; a++ {
+		ma := md.ToMontgomery(uint8(a))
+		if md.FromMontgomery(ma) != uint8(a) {
+			t.Errorf("expected element %d to survive conversion", a)
+		}
+		if a != 0 && md.FromMontgomery(md.MultInverse(ma)) != field.MultInverse(uint8(a)) {
+			t.Errorf("expected inverse of %d to match", a)
+		}
+
+		for b := 0; b < 256; b++ {
+			mb := md.ToMontgomery(uint8(b))
+			if product := md.FromMontgomery(md.Mul(ma, mb)); product != field.Mul(uint8(a), uint8(b)) {
+				t.Fatalf("expected %d * %d = %d, got %d", a, b, field.Mul(uint8(a), uint8(b)), product)
+			}
+		}
+	}
+}
+
+func TestMontgomeryDomain_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, prime := range []Polynomial{PrimePolynomialDegree2, PrimePolynomialDegree19, PrimePolynomialDegree32} {
+		field := NewField[uint32](prime)
+		md := field.Montgomery()
+		mask := uint32(field.Order() - 1)
+
+		// The Montgomery product of standard elements carries a factor of x^-m.
+		xInverseM := field.Exp(field.MultInverse(2), prime.Degree())
+
+		for trial := 0; trial < 500; trial++ {
+			a, b := rng.Uint32()&mask, rng.Uint32()&mask|1
+			e := rng.Uint64() >> 40
+			ma, mb := md.ToMontgomery(a), md.ToMontgomery(b)
+
+			if md.Mul(a, b) != field.Mul(a, b, xInverseM) {
+				t.Fatalf("expected Montgomery product of %d and %d to be a*b*x^-m", a, b)
+			}
+			if md.FromMontgomery(md.Mul(ma, mb, ma)) != field.Mul(a, b, a) {
+				t.Fatalf("expected products of %d and %d to match", a, b)
+			}
+			if md.FromMontgomery(md.Div(ma, mb)) != field.Div(a, b) {
+				t.Fatalf("expected quotients of %d and %d to match", a, b)
+			}
+			if md.FromMontgomery(md.Exp(mb, e)) != field.Exp(b, e) {
+				t.Fatalf("expected %d^%d to match", b, e)
+			}
+		}
+	}
+}
+
+var benchmarkPrimes = []Polynomial{
+	PrimePolynomialDegree8,
+	PrimePolynomialDegree9,
+	PrimePolynomialDegree10,
+	PrimePolynomialDegree11,
+	PrimePolynomialDegree12,
+	PrimePolynomialDegree13,
+	PrimePolynomialDegree14,
+	PrimePolynomialDegree15,
+	PrimePolynomialDegree16,
+	PrimePolynomialDegree17,
+	PrimePolynomialDegree18,
+	PrimePolynomialDegree19,
+	PrimePolynomialDegree20,
+	PrimePolynomialDegree21,
+	PrimePolynomialDegree22,
+	PrimePolynomialDegree23,
+	PrimePolynomialDegree24,
+	PrimePolynomialDegree25,
+	PrimePolynomialDegree26,
+	PrimePolynomialDegree27,
+	PrimePolynomialDegree28,
+	PrimePolynomialDegree29,
+	PrimePolynomialDegree30,
+	PrimePolynomialDegree31,
+	PrimePolynomialDegree32,
+}
+
+func BenchmarkMontgomeryDomain_Mul(b *testing.B) {
+	for _, prime := range benchmarkPrimes {
+		field := NewField[uint32](prime)
+		md := field.Montgomery()
+
+		// An operand with about half of its coefficients set.
+		x := uint32(0x5A5A5A5A & (field.Order() - 1))
+		y := uint32(0xC3C3C3C3 & (field.Order() - 1))
+
+		b.Run(fmt.Sprintf("Field/%d", prime.Degree()), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				field.Mul(x, y)
+			}
+		})
+		b.Run(fmt.Sprintf("Montgomery/%d", prime.Degree()), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				md.Mul(x, y)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package galois
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// IsNormalElement returns true if the conjugates of a, which are a, a^2, a^4,
+// ..., a^(2^(m-1)), are linearly independent, and so form a normal basis of the
+// field.
+func (field *Field[T]) IsNormalElement(a T) bool {
+	_, ok := field.conjugateMatrix(a).echelon()
+	return ok
+}
+
+// NormalElement returns the smallest element of the field which is a normal
+// element. Every finite field has a normal element.
+func (field *Field[T]) NormalElement() T {
+	for a := T(1); uint64(a) < field.Order(); a++ {
+		if field.IsNormalElement(a) {
+			return a
+		}
+	}
+	panic(fmt.Sprintf("failed to find normal element; is %s irreducible?", field.Prime))
+}
+
+// conjugateMatrix returns the matrix whose columns are the conjugates of a.
+func (field *Field[T]) conjugateMatrix(a T) bitMatrix {
+	m := field.Prime.Degree()
+	conjugates := make(bitMatrix, m)
+	for i := range conjugates {
+		conjugates[i] = uint64(a)
+		a = field.Mul(a, a)
+	}
+	return conjugates
+}
+
+// NormalField performs arithmetic in GF(2^m) using a normal basis, in which an
+// element is represented by its coordinates over the conjugates of a normal
+// element b: bit i of an element is the coefficient of b^(2^i).
+//
+// In a normal basis, squaring an element is a cyclic rotation of its bits, and
+// the multiplicative identity 1 is represented by the element with all bits set.
+// Multiplication uses the Massey-Omura method: the lowest coordinate of a product
+// is a fixed bilinear form of the two factors, and every other coordinate is
+// the same bilinear form applied to rotations of the factors. This is the
+// method used by many hardware multipliers, and by ANSI X9.62 for elliptic curves
+// over Gaussian normal bases.
+type NormalField[T IntLike] struct {
+	field   *Field[T]
+	element T
+	width   uint64
+
+	toNormal   bitMatrix
+	fromNormal bitMatrix
+
+	// Bit j of lambda[i] is the coefficient of b in b^(2^i) * b^(2^j).
+	lambda []uint64
+}
+
+// NewNormalField creates a NormalField over the given prime polynomial, using
+// the smallest normal element of the field as the basis element.
+//
+// Panics if the type parameter T is not of sufficient size to represent every
+// element in the field.
+func NewNormalField[T IntLike](prime Polynomial) *NormalField[T] {
+	field := NewField[T](prime)
+	return field.NormalBasis(field.NormalElement())
+}
+
+// NormalBasis returns a NormalField which represents elements of this field over
+// the normal basis generated by the given element.
+//
+// Panics if element is not a normal element.
+func (field *Field[T]) NormalBasis(element T) *NormalField[T] {
+	fromNormal := field.conjugateMatrix(element)
+	toNormal, ok := fromNormal.inverse()
+	if !ok {
+		panic(fmt.Sprintf("element %d is not a normal element of field over %s", element, field.Prime))
+	}
+
+	m := field.Prime.Degree()
+	lambda := make([]uint64, m)
+	for i := range lambda {
+		for j := uint64(0); j < m; j++ {
+			product := field.Mul(T(fromNormal[i]), T(fromNormal[j]))
+			lambda[i] |= (toNormal.mulVec(uint64(product)) & 1) << j
+		}
+	}
+
+	return &NormalField[T]{
+		field:      field,
+		element:    element,
+		width:      m,
+		toNormal:   toNormal,
+		fromNormal: fromNormal,
+		lambda:     lambda,
+	}
+}
+
+// Field returns the polynomial-basis Field of the same order.
+func (nf *NormalField[T]) Field() *Field[T] {
+	return nf.field
+}
+
+// Element returns the normal element which generates the basis, in the
+// polynomial-basis representation.
+func (nf *NormalField[T]) Element() T {
+	return nf.element
+}
+
+// Complexity returns the number of non-zero terms in the multiplication matrix
+// of the normal basis, which determines the cost of a Massey-Omura multiplier
+// in hardware. It is at least 2m - 1, which is reached by optimal normal bases.
+func (nf *NormalField[T]) Complexity() int {
+	var count int
+	for _, row := range nf.lambda {
+		count += bits.OnesCount64(row)
+	}
+	return count
+}
+
+// FromField converts the element a from the polynomial basis to the normal basis.
+func (nf *NormalField[T]) FromField(a T) T {
+	return T(nf.toNormal.mulVec(uint64(a)))
+}
+
+// ToField converts the element a from the normal basis to the polynomial basis.
+func (nf *NormalField[T]) ToField(a T) T {
+	return T(nf.fromNormal.mulVec(uint64(a)))
+}
+
+// One returns the multiplicative identity element, which has every bit set.
+func (nf *NormalField[T]) One() T {
+	return T(uint64(1)<<nf.width - 1)
+}
+
+// rotate cyclically shifts the m bits of a towards higher indexes by k places,
+// which raises a to the power 2^k.
+func (nf *NormalField[T]) rotate(a uint64, k uint64) uint64 {
+	k %= nf.width
+	if k == 0 {
+		return a
+	}
+	mask := uint64(1)<<nf.width - 1
+	return ((a << k) | (a >> (nf.width - k))) & mask
+}
+
+// Add computes the sum of the given elements. Addition is the same in every basis.
+func (nf *NormalField[T]) Add(values ...T) T {
+	return nf.field.Add(values...)
+}
+
+// Sub computes the difference of the given elements (a - b), which is the same as
+// their sum.
+func (nf *NormalField[T]) Sub(a, b T) T {
+	return nf.field.Sub(a, b)
+}
+
+// Square returns a^2, which in a normal basis is a rotation of a by one place.
+func (nf *NormalField[T]) Square(a T) T {
+	return T(nf.rotate(uint64(a), 1))
+}
+
+// Frobenius returns a^(2^k), which in a normal basis is a rotation of a by k places.
+func (nf *NormalField[T]) Frobenius(a T, k uint64) T {
+	return T(nf.rotate(uint64(a), k))
+}
+
+// Trace returns the absolute trace of the element a, which in a normal basis is
+// the parity of its bits.
+func (nf *NormalField[T]) Trace(a T) T {
+	return T(bits.OnesCount64(uint64(a)) & 1)
+}
+
+// Mul multiplies a set of elements and returns the product, using the
+// Massey-Omura method. If called with no parameters, Mul returns zero.
+func (nf *NormalField[T]) Mul(values ...T) T {
+	if len(values) == 0 {
+		return 0
+	}
+	product := uint64(values[0])
+	for _, v := range values[1:] {
+		product = nf.mul(product, uint64(v))
+	}
+	return T(product)
+}
+
+// mul multiplies two elements in the normal basis. Coordinate k of the product
+// of a and b is coordinate 0 of the product of a^(2^-k) and b^(2^-k).
+func (nf *NormalField[T]) mul(a, b uint64) (product uint64) {
+	for k := uint64(0); k < nf.width; k++ {
+		ak := nf.rotate(a, nf.width-k)
+		bk := nf.rotate(b, nf.width-k)
+
+		var sum uint64
+		for i, row := range nf.lambda {
+			if (ak>>i)&1 == 1 {
+				sum ^= row & bk
+			}
+		}
+		product |= uint64(bits.OnesCount64(sum)&1) << k
+	}
+	return
+}
+
+// MultInverse computes the multiplicative inverse of a, as the product of its
+// conjugates a^(2^i) for i from 1 to m-1, which is a^(2^m - 2).
+//
+// Panics if a is the additive identity element zero.
+func (nf *NormalField[T]) MultInverse(a T) T {
+	if a == 0 {
+		panic("division by zero error")
+	}
+
+	inverse := nf.rotate(uint64(a), 1)
+	for i := uint64(2); i < nf.width; i++ {
+		inverse = nf.mul(inverse, nf.rotate(uint64(a), i))
+	}
+	return T(inverse)
+}
+
+// Div returns the division of the numerator element by the denominator element.
+//
+// Panics if denominator is the additive identity element zero.
+func (nf *NormalField[T]) Div(numerator, denominator T) T {
+	return T(nf.mul(uint64(numerator), uint64(nf.MultInverse(denominator))))
+}
+
+// Exp multiplies the base element by itself the given number of times, using
+// left-to-right square and multiply.
+//
+// If exponent is zero, returns the multiplicative identity.
+func (nf *NormalField[T]) Exp(base T, exponent uint64) T {
+	result := uint64(nf.One())
+	for i := bits.Len64(exponent) - 1; i >= 0; i-- {
+		result = nf.rotate(result, 1)
+		if (exponent>>i)&1 == 1 {
+			result = nf.mul(result, uint64(base))
+		}
+	}
+	return T(result)
+}
@@ -0,0 +1,110 @@
+package galois
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestField_IsNormalElement(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+
+	var count int
+	for a := 0; a < 256; a++ {
+		if field.IsNormalElement(uint8(a)) {
+			count++
+			if field.Trace(uint8(a)) != 1 {
+				t.Errorf("expected normal element %d to have trace one", a)
+			}
+		}
+	}
+
+	// GF(2^8) has 128 normal elements.
+	if count != 128 {
+		t.Errorf("expected 128 normal elements in GF(2^8), found %d", count)
+	}
+	if field.IsNormalElement(0) || field.IsNormalElement(1) {
+		t.Errorf("expected zero and one not to be normal elements")
+	}
+}
+
+func TestNormalField_MatchesField(t *testing.T) {
+	nf := NewNormalField[uint8](PrimePolynomialDegree8)
+	field := nf.Field()
+
+	if nf.ToField(nf.One()) != 1 {
+		t.Errorf("expected all-ones element to be the multiplicative identity")
+	}
+	if nf.ToField(1) != nf.Element() {
+		t.Errorf("expected lowest basis vector to be the normal element")
+	}
+
+	for a := 0; a < 256; a++ {
+		na := nf.FromField(uint8(a))
+		if nf.ToField(na) != uint8(a) {
+			t.Errorf("expected element %d to survive conversion", a)
+		}
+		if nf.ToField(nf.Square(na)) != field.Mul(uint8(a), uint8(a)) {
+			t.Errorf("expected rotation to square %d", a)
+		}
+		if nf.Trace(na) != field.Trace(uint8(a)) {
+			t.Errorf("expected trace of %d to match", a)
+		}
+		if a != 0 && nf.ToField(nf.MultInverse(na)) != field.MultInverse(uint8(a)) {
+			t.Errorf("expected inverse of %d to match", a)
+		}
+
+		for b := 0; b < 256; b++ {
+			nb := nf.FromField(uint8(b))
+			if product := nf.ToField(nf.Mul(na, nb)); product != field.Mul(uint8(a), uint8(b)) {
+				t.Fatalf("expected %d * %d = %d, got %d", a, b, field.Mul(uint8(a), uint8(b)), product)
+			}
+		}
+	}
+}
+
+func TestNormalField_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	nf := NewNormalField[uint32](PrimePolynomialDegree23)
+	field := nf.Field()
+	mask := uint32(field.Order() - 1)
+
+	for trial := 0; trial < 200; trial++ {
+		a, b := rng.Uint32()&mask, rng.Uint32()&mask
+		na, nb := nf.FromField(a), nf.FromField(b)
+
+		if nf.ToField(nf.Mul(na, nb)) != field.Mul(a, b) {
+			t.Fatalf("expected products of %d and %d to match", a, b)
+		}
+		if b != 0 && nf.ToField(nf.Div(na, nb)) != field.Div(a, b) {
+			t.Fatalf("expected quotients of %d and %d to match", a, b)
+		}
+		if nf.ToField(nf.Exp(na, 1000)) != field.Exp(a, 1000) {
+			t.Fatalf("expected %d^1000 to match", a)
+		}
+		if nf.ToField(nf.Frobenius(na, 5)) != field.Frobenius(a, 5) {
+			t.Fatalf("expected Frobenius of %d to match", a)
+		}
+	}
+}
+
+func TestNormalField_OptimalComplexity(t *testing.T) {
+	// x^4 + x^3 + x^2 + x + 1 has the primitive 5th roots of unity as its roots,
+	// which form a type I optimal normal basis of GF(2^4).
+	nf := NewField[uint8](0b11111).NormalBasis(2)
+	if complexity := nf.Complexity(); complexity != 7 {
+		t.Errorf("expected optimal normal basis of GF(2^4) to have complexity 7, got %d", complexity)
+	}
+
+	if complexity := NewNormalField[uint8](PrimePolynomialDegree8).Complexity(); complexity < 15 {
+		t.Errorf("complexity %d is below the lower bound 2m - 1", complexity)
+	}
+}
+
+func TestField_NormalBasis_Panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected to panic when using a non-normal element as a basis")
+		}
+	}()
+	NewField[uint8](PrimePolynomialDegree8).NormalBasis(1)
+}
@@ -0,0 +1,250 @@
+package galois
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// ParsePolynomial parses a Polynomial from text. It accepts:
+//
+//   - The standard form produced by Polynomial.String, such as
+//     "x^8 + x^4 + x^3 + x^2 + 1". Spaces are optional, x may be written in
+//     either case, and the terms "x", "x^1", "1" and "x^0" are all accepted.
+//     The zero polynomial is written "0".
+//   - An integer whose bits are the coefficients, in decimal, or in hex, binary or
+//     octal with a 0x, 0b or 0o prefix, such as "0x11D" or "285". Without a
+//     prefix, the integer is always decimal, even with leading zeros.
+//   - A list of the exponents of the non-zero terms, separated by commas and
+//     optionally enclosed in brackets, such as "8, 4, 3, 2, 0" or "[8,4,3,2,0]".
+//   - CRC notations, which omit one term of the polynomial:
+//     "koopman:0x8E" is Koopman notation, which omits the constant term.
+//     "reversed:8:0xB8" is reversed notation, which omits the highest term, x^8,
+//     and holds the coefficient of x^0 in its most significant bit.
+//     "normal:8:0x1D" is normal notation, which omits the highest term, x^8.
+//     "reciprocal:8:0x71" is reciprocal notation, the normal notation of the
+//     reciprocal polynomial x^8 * p(1/x), which omits the constant term of p.
+//
+// For example, each of these forms parses as the Polynomial 0x11D.
+//
+// Returns an error wrapping ErrInvalidPolynomial if s cannot be parsed, or
+// ErrOverflow if the polynomial has degree greater than 63.
+func ParsePolynomial(s string) (Polynomial, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("%w: empty string", ErrInvalidPolynomial)
+	}
+
+	if notation, value, ok := strings.Cut(s, ":"); ok {
+		return parseCRCNotation(strings.ToLower(strings.TrimSpace(notation)), value)
+	}
+
+	if strings.HasPrefix(s, "[") || strings.Contains(s, ",") {
+		return parseExponentList(s)
+	}
+
+	if v, err := parseUint(s); err == nil {
+		return Polynomial(v), nil
+	}
+
+	return parseStandardForm(s)
+}
+
+// parseStandardForm parses a polynomial written as a sum of terms, such as
+// "x^8 + x^4 + x^3 + x^2 + 1".
+func parseStandardForm(s string) (Polynomial, error) {
+	var p Polynomial
+	for _, term := range strings.Split(s, "+") {
+		term = strings.Join(strings.Fields(term), "")
+
+		var exponent uint64
+		switch {
+		case term == "1":
+			exponent = 0
+
+		case term == "x" || term == "X":
+			exponent = 1
+
+		case strings.HasPrefix(term, "x^") || strings.HasPrefix(term, "X^"):
+			e, err := strconv.ParseUint(term[2:], 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("%w: invalid exponent in term %q", ErrInvalidPolynomial, term)
+			}
+			exponent = e
+
+		default:
+			return 0, fmt.Errorf("%w: invalid term %q", ErrInvalidPolynomial, term)
+		}
+
+		if err := addTerm(&p, exponent); err != nil {
+			return 0, err
+		}
+	}
+	return p, nil
+}
+
+// parseExponentList parses a list of the exponents of a polynomial's non-zero
+// terms, such as "[8, 4, 3, 2, 0]".
+func parseExponentList(s string) (Polynomial, error) {
+	if strings.HasPrefix(s, "[") {
+		if !strings.HasSuffix(s, "]") {
+			return 0, fmt.Errorf("%w: unterminated exponent list %q", ErrInvalidPolynomial, s)
+		}
+		s = s[1 : len(s)-1]
+	}
+
+	var p Polynomial
+	if strings.TrimSpace(s) == "" {
+		return p, nil
+	}
+	for _, field := range strings.Split(s, ",") {
+		exponent, err := strconv.ParseUint(strings.TrimSpace(field), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: invalid exponent %q", ErrInvalidPolynomial, field)
+		}
+		if err := addTerm(&p, exponent); err != nil {
+			return 0, err
+		}
+	}
+	return p, nil
+}
+
+// addTerm adds the term x^exponent to p. Returns an error if the exponent is
+// greater than 63, or the term is already present.
+func addTerm(p *Polynomial, exponent uint64) error {
+	if exponent > 63 {
+		return fmt.Errorf("%w: term x^%d has degree greater than 63", ErrOverflow, exponent)
+	}
+	term := Polynomial(1) << exponent
+	if *p&term != 0 {
+		return fmt.Errorf("%w: duplicate term x^%d", ErrInvalidPolynomial, exponent)
+	}
+	*p |= term
+	return nil
+}
+
+// parseCRCNotation parses a polynomial written in one of the notations used to
+// describe CRCs, given the name of the notation and the rest of the string.
+func parseCRCNotation(notation, s string) (Polynomial, error) {
+	switch notation {
+	case "koopman":
+		v, err := parseNotationValue(s)
+		if err != nil {
+			return 0, err
+		} else if v>>63 != 0 {
+			return 0, fmt.Errorf("%w: Koopman value %#x has degree greater than 63", ErrOverflow, v)
+		}
+		return Polynomial(v<<1 | 1), nil
+
+	case "normal", "reversed", "reciprocal":
+		widthText, valueText, ok := strings.Cut(s, ":")
+		if !ok {
+			return 0, fmt.Errorf("%w: %s notation requires a width, such as %q", ErrInvalidPolynomial, notation, notation+":8:0x1D")
+		}
+		width, err := strconv.ParseUint(strings.TrimSpace(widthText), 10, 64)
+		if err != nil || width == 0 {
+			return 0, fmt.Errorf("%w: invalid width %q", ErrInvalidPolynomial, widthText)
+		} else if width > 63 {
+			return 0, fmt.Errorf("%w: width %d is greater than 63", ErrOverflow, width)
+		}
+
+		v, err := parseNotationValue(valueText)
+		if err != nil {
+			return 0, err
+		} else if v>>width != 0 {
+			return 0, fmt.Errorf("%w: value %#x does not fit in width %d", ErrInvalidPolynomial, v, width)
+		}
+
+		switch notation {
+		case "reversed":
+			return reverseBits(v, width) | Polynomial(1)<<width, nil
+		case "reciprocal":
+			return reverseBits(v|1<<width, width+1), nil
+		}
+		return Polynomial(v) | Polynomial(1)<<width, nil
+	}
+
+	return 0, fmt.Errorf("%w: unknown notation %q", ErrInvalidPolynomial, notation)
+}
+
+// parseNotationValue parses the integer value of a CRC notation.
+func parseNotationValue(s string) (uint64, error) {
+	v, err := parseUint(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid value %q", ErrInvalidPolynomial, s)
+	}
+	return v, nil
+}
+
+// parseUint parses an unsigned integer, in hex, binary or octal if it has a 0x,
+// 0b or 0o prefix, and in decimal otherwise. Unlike strconv.ParseUint with base
+// zero, a leading zero alone does not select octal, so "010" is ten.
+func parseUint(s string) (uint64, error) {
+	if len(s) > 2 && s[0] == '0' {
+		switch s[1] {
+		case 'x', 'X':
+			return strconv.ParseUint(s[2:], 16, 64)
+		case 'b', 'B':
+			return strconv.ParseUint(s[2:], 2, 64)
+		case 'o', 'O':
+			return strconv.ParseUint(s[2:], 8, 64)
+		}
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// reverseBits returns the lowest width bits of v in reverse order.
+func reverseBits(v uint64, width uint64) Polynomial {
+	return Polynomial(bits.Reverse64(v) >> (64 - width))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the polynomial in the
+// standard form produced by String, or "0" for the zero polynomial.
+//
+// Because Polynomial implements encoding.TextMarshaler, encoding/json encodes it
+// as a JSON string, such as "x^8 + x^4 + x^3 + x^2 + 1". Earlier versions of this
+// package encoded it as a JSON number; such payloads can still be decoded by
+// UnmarshalJSON, but consumers of encoded JSON must now expect a string.
+func (p Polynomial) MarshalText() ([]byte, error) {
+	if p == 0 {
+		return []byte("0"), nil
+	}
+	return []byte(p.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It decodes a JSON string in any
+// form accepted by ParsePolynomial, or a JSON number, which is how a Polynomial
+// was encoded before it implemented encoding.TextMarshaler.
+func (p *Polynomial) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		return p.UnmarshalText([]byte(text))
+	}
+
+	v, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: invalid JSON value %s", ErrInvalidPolynomial, data)
+	}
+	*p = Polynomial(v)
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding any form accepted
+// by ParsePolynomial.
+func (p *Polynomial) UnmarshalText(text []byte) error {
+	parsed, err := ParsePolynomial(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
@@ -0,0 +1,191 @@
+package galois
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParsePolynomial(t *testing.T) {
+	type TestCase struct {
+		Input    string
+		Expected Polynomial
+	}
+
+	testCases := []TestCase{
+		// Standard form.
+		{"x^8 + x^4 + x^3 + x^2 + 1", 0x11D},
+		{"x^8+x^4+x^3+x^2+1", 0x11D},
+		{"  X^8 + x ^ 4 + x^3 + x^2 + x^0  ", 0x11D},
+		{"x^2 + x^1 + 1", 0b111},
+		{"x^2 + x + 1", 0b111},
+		{"1 + x + x^3", 0b1011},
+		{"x", 0b10},
+		{"x^63", 1 << 63},
+
+		// Integers.
+		{"0x11D", 0x11D},
+		{"0X11d", 0x11D},
+		{"285", 0x11D},
+		{"0b100011101", 0x11D},
+		{"0o435", 0x11D},
+		{"0", 0},
+		{"1", 1},
+		{"010", 10},
+		{"0285", 0x11D},
+
+		// Exponent lists.
+		{"8, 4, 3, 2, 0", 0x11D},
+		{"[8,4,3,2,0]", 0x11D},
+		{"[ 0, 2, 3, 4, 8 ]", 0x11D},
+		{"[3]", 0b1000},
+		{"[]", 0},
+
+		// CRC notations.
+		{"koopman:0x8E", 0x11D},
+		{"Koopman: 0x8E", 0x11D},
+		{"reversed:8:0xB8", 0x11D},
+		{"normal:8:0x1D", 0x11D},
+		{"reciprocal:8:0x71", 0x11D},
+		{"koopman:0x82608EDB", 0x104C11DB7},
+		{"reversed:32:0xEDB88320", 0x104C11DB7},
+		{"reversed:8:0x20", 0x104}, // x^8 + x^2, whose reversed form has its top bit clear
+		{"reversed:8:0xA0", 0x105}, // x^8 + x^2 + 1
+		{"normal:32:0x04C11DB7", 0x104C11DB7},
+		{"reciprocal:32:0xDB710641", 0x104C11DB7},
+		{"normal:16:0x1021", 0x11021},
+	}
+
+	for _, test := range testCases {
+		p, err := ParsePolynomial(test.Input)
+		if err != nil {
+			t.Errorf("failed to parse %q: %s", test.Input, err)
+		} else if p != test.Expected {
+			t.Errorf("expected %q to parse as %#x, got %#x", test.Input, uint64(test.Expected), uint64(p))
+		}
+	}
+}
+
+func TestParsePolynomial_Errors(t *testing.T) {
+	type TestCase struct {
+		Input string
+		Err   error
+	}
+
+	testCases := []TestCase{
+		{"", ErrInvalidPolynomial},
+		{"   ", ErrInvalidPolynomial},
+		{"x^8 + + 1", ErrInvalidPolynomial},
+		{"x^8 + 2", ErrInvalidPolynomial},
+		{"y^2 + 1", ErrInvalidPolynomial},
+		{"x^-1", ErrInvalidPolynomial},
+		{"x^4 + x^4", ErrInvalidPolynomial},
+		{"x^64 + 1", ErrOverflow},
+		{"-1", ErrInvalidPolynomial},
+		{"0x1G", ErrInvalidPolynomial},
+		{"0b102", ErrInvalidPolynomial},
+		{"0o8", ErrInvalidPolynomial},
+		{"normal:8:0x", ErrInvalidPolynomial},
+		{"[8, 4", ErrInvalidPolynomial},
+		{"8, four", ErrInvalidPolynomial},
+		{"[64, 0]", ErrOverflow},
+		{"koopman:", ErrInvalidPolynomial},
+		{"koopman:0x8000000000000000", ErrOverflow},
+		{"reversed:0xB8", ErrInvalidPolynomial},
+		{"reversed:4:0xB8", ErrInvalidPolynomial},
+		{"reversed:64:0x1", ErrOverflow},
+		{"normal:0x1D", ErrInvalidPolynomial},
+		{"normal:4:0x1D", ErrInvalidPolynomial},
+		{"normal:64:0x1D", ErrOverflow},
+		{"reciprocal:0:1", ErrInvalidPolynomial},
+		{"unknown:0x1D", ErrInvalidPolynomial},
+	}
+
+	for _, test := range testCases {
+		if p, err := ParsePolynomial(test.Input); !errors.Is(err, test.Err) {
+			t.Errorf("expected %q to fail with %v, got %#x (%v)", test.Input, test.Err, uint64(p), err)
+		}
+	}
+}
+
+func TestPolynomial_TextRoundTrip(t *testing.T) {
+	for _, p := range []Polynomial{0, 1, 2, 0x11D, PrimePolynomialDegree32, 1<<63 | 1} {
+		text, err := p.MarshalText()
+		if err != nil {
+			t.Fatalf("failed to marshal %#x: %s", uint64(p), err)
+		}
+
+		var decoded Polynomial
+		if err := decoded.UnmarshalText(text); err != nil {
+			t.Fatalf("failed to unmarshal %q: %s", text, err)
+		}
+		if decoded != p {
+			t.Errorf("expected %q to round-trip to %#x, got %#x", text, uint64(p), uint64(decoded))
+		}
+
+		if parsed, err := ParsePolynomial(p.String()); p != 0 && (err != nil || parsed != p) {
+			t.Errorf("expected String() of %#x to parse, got %#x (%v)", uint64(p), uint64(parsed), err)
+		}
+	}
+}
+
+func TestPolynomial_JSON(t *testing.T) {
+	type Config struct {
+		Prime Polynomial   `json:"prime"`
+		CRCs  []Polynomial `json:"crcs"`
+	}
+
+	config := Config{Prime: 0x11D, CRCs: []Polynomial{0x107, 0}}
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to encode config: %s", err)
+	}
+	expected := `{"prime":"x^8 + x^4 + x^3 + x^2 + 1","crcs":["x^8 + x^2 + x + 1","0"]}`
+	if string(encoded) != expected {
+		t.Errorf("expected JSON %s, got %s", expected, encoded)
+	}
+
+	var decoded Config
+	input := `{"prime":"0x11D","crcs":["koopman:0x83","[8, 2, 1, 0]"]}`
+	if err := json.Unmarshal([]byte(input), &decoded); err != nil {
+		t.Fatalf("failed to decode config: %s", err)
+	}
+	if decoded.Prime != 0x11D || len(decoded.CRCs) != 2 || decoded.CRCs[0] != 0x107 || decoded.CRCs[1] != 0x107 {
+		t.Errorf("decoded unexpected config %+v", decoded)
+	}
+
+	if err := json.Unmarshal([]byte(`{"prime":"x^8 + 3"}`), &decoded); !errors.Is(err, ErrInvalidPolynomial) {
+		t.Errorf("expected ErrInvalidPolynomial when decoding invalid config, got %v", err)
+	}
+}
+
+func TestPolynomial_JSONNumbers(t *testing.T) {
+	// Polynomials were encoded as JSON numbers before implementing
+	// encoding.TextMarshaler, and such payloads must still decode.
+	type Config struct {
+		Prime Polynomial
+		CRCs  []Polynomial
+	}
+
+	var decoded Config
+	input := `{"Prime":285,"CRCs":[263,"0x107",18446744073709551615]}`
+	if err := json.Unmarshal([]byte(input), &decoded); err != nil {
+		t.Fatalf("failed to decode config: %s", err)
+	}
+	if decoded.Prime != 0x11D || len(decoded.CRCs) != 3 ||
+		decoded.CRCs[0] != 0x107 || decoded.CRCs[1] != 0x107 || decoded.CRCs[2] != 1<<64-1 {
+		t.Errorf("decoded unexpected config %+v", decoded)
+	}
+
+	for _, input := range []string{`{"Prime":-1}`, `{"Prime":2.5}`, `{"Prime":18446744073709551616}`, `{"Prime":true}`} {
+		if err := json.Unmarshal([]byte(input), &decoded); err == nil {
+			t.Errorf("expected error decoding %s", input)
+		}
+	}
+
+	// null leaves the polynomial unchanged, as for other types.
+	decoded.Prime = 0x11D
+	if err := json.Unmarshal([]byte(`{"Prime":null}`), &decoded); err != nil || decoded.Prime != 0x11D {
+		t.Errorf("expected null to leave polynomial unchanged, got %#x (%v)", uint64(decoded.Prime), err)
+	}
+}
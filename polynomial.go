@@ -1,6 +1,7 @@
 package galois
 
 import (
+	"fmt"
 	"math/bits"
 	"strconv"
 	"strings"
@@ -86,6 +87,32 @@ func (a Polynomial) Mul(b Polynomial) (product Polynomial) {
 	return
 }
 
+// TryMul returns the product of the polynomials a and b, like Mul, but returns
+// an error wrapping ErrOverflow instead of panicking if the product's degree
+// would be greater than 63.
+func (a Polynomial) TryMul(b Polynomial) (Polynomial, error) {
+	if a != 0 && b != 0 && a.Degree()+b.Degree() > 63 {
+		return 0, fmt.Errorf("%w: cannot multiply polynomials of degrees %d and %d", ErrOverflow, a.Degree(), b.Degree())
+	}
+	return a.Mul(b), nil
+}
+
+// square returns the polynomial p multiplied by itself. Squaring a polynomial
+// with coefficients modulo two spreads its coefficients apart, so that the
+// coefficient of x^i moves to x^(2i), because every cross term appears twice and
+// cancels out.
+//
+// The degree of p must be at most 31.
+func (p Polynomial) square() Polynomial {
+	v := uint64(p) & 0xFFFFFFFF
+	v = (v | v<<16) & 0x0000FFFF0000FFFF
+	v = (v | v<<8) & 0x00FF00FF00FF00FF
+	v = (v | v<<4) & 0x0F0F0F0F0F0F0F0F
+	v = (v | v<<2) & 0x3333333333333333
+	v = (v | v<<1) & 0x5555555555555555
+	return Polynomial(v)
+}
+
 // Div divides the numerator polynomial by the given denominator polynomial and
 // returns the quotient and remainder.
 //
@@ -109,6 +136,17 @@ func (numerator Polynomial) Div(denominator Polynomial) (quotient, remainder Pol
 	}
 }
 
+// TryDiv divides the numerator polynomial by the given denominator polynomial
+// and returns the quotient and remainder, like Div, but returns an error wrapping
+// ErrDivisionByZero instead of panicking if denominator is zero.
+func (numerator Polynomial) TryDiv(denominator Polynomial) (quotient, remainder Polynomial, err error) {
+	if denominator == 0 {
+		return 0, 0, fmt.Errorf("%w: cannot divide polynomial by zero", ErrDivisionByZero)
+	}
+	quotient, remainder = numerator.Div(denominator)
+	return quotient, remainder, nil
+}
+
 // Mod divides the numerator polynomial by the given denominator polynomial and
 // returns only the remainder.
 //
@@ -118,6 +156,14 @@ func (numerator Polynomial) Mod(denominator Polynomial) Polynomial {
 	return rem
 }
 
+// TryMod returns the remainder of dividing the numerator polynomial by the given
+// denominator polynomial, like Mod, but returns an error wrapping ErrDivisionByZero
+// instead of panicking if denominator is zero.
+func (numerator Polynomial) TryMod(denominator Polynomial) (Polynomial, error) {
+	_, remainder, err := numerator.TryDiv(denominator)
+	return remainder, err
+}
+
 // Exp exponentiates the base Polynomial to the power of the given exponent,
 // modulo the given modulus Polynomial, using the square & multiply algorithm.
 //
@@ -145,3 +191,101 @@ func (base Polynomial) Exp(exponent uint64, modulus Polynomial) Polynomial {
 
 	return result
 }
+
+// GCD returns the greatest common divisor of the polynomials a and b, using the
+// euclidean algorithm.
+func (a Polynomial) GCD(b Polynomial) Polynomial {
+	for b != 0 {
+		a, b = b, a.Mod(b)
+	}
+	return a
+}
+
+// powXSquared returns x^(2^k) modulo the given modulus, by squaring x k times.
+func powXSquared(k uint64, modulus Polynomial) Polynomial {
+	result := Polynomial(2).Mod(modulus)
+	for i := uint64(0); i < k; i++ {
+		result = result.Mul(result).Mod(modulus)
+	}
+	return result
+}
+
+// IsIrreducible returns true if p cannot be factored into the product of two
+// polynomials of lesser degree, using Rabin's test of irreducibility.
+//
+// Only irreducible polynomials can be used as the prime polynomial of a Field.
+//
+// Panics if the degree of p is greater than 32.
+func (p Polynomial) IsIrreducible() bool {
+	degree := p.Degree()
+	if degree == 0 {
+		return false
+	}
+
+	// p is irreducible iff x^(2^n) = x mod p, and x^(2^(n/q)) - x is coprime
+	// with p for every prime factor q of n.
+	if powXSquared(degree, p) != Polynomial(2).Mod(p) {
+		return false
+	}
+	for _, q := range primeFactors(degree) {
+		if powXSquared(degree/q, p).Add(2).GCD(p) != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPrimitive returns true if p is irreducible and the Generator element x has
+// order 2^n - 1 modulo p, where n is the degree of p. In a Field whose prime
+// polynomial is primitive, Generate produces every non-zero element.
+//
+// Panics if the degree of p is greater than 32.
+func (p Polynomial) IsPrimitive() bool {
+	if p&1 == 0 || !p.IsIrreducible() {
+		return false
+	}
+
+	groupOrder := fieldOrder(p) - 1
+	for _, q := range primeFactors(groupOrder) {
+		if Generator.Exp(groupOrder/q, p).Mod(p) == 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// Period returns the smallest positive integer e such that p divides x^e + 1.
+// This is also called the order or exponent of p. The sequence produced by a
+// linear feedback shift register whose feedback polynomial is p always has a
+// period which divides this number, and if p is irreducible, any non-zero seed
+// produces a sequence with exactly this period. Period returns zero if p has no
+// constant term, because then no such e exists.
+//
+// For irreducible polynomials, the period is a divisor of 2^n - 1, and is found
+// quickly. For reducible polynomials, Period steps through successive powers of x,
+// which takes time proportional to the period.
+//
+// Panics if the degree of p is greater than 32.
+func (p Polynomial) Period() uint64 {
+	if p&1 == 0 || p.Degree() == 0 {
+		return 0
+	}
+
+	if !p.IsIrreducible() {
+		power := Generator.Mod(p)
+		e := uint64(1)
+		for power != 1 {
+			power = power.Mul(Generator).Mod(p)
+			e++
+		}
+		return e
+	}
+
+	period := fieldOrder(p) - 1
+	for _, q := range primeFactors(period) {
+		for period%q == 0 && Generator.Exp(period/q, p).Mod(p) == 1 {
+			period /= q
+		}
+	}
+	return period
+}
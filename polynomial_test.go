@@ -239,3 +239,83 @@ func BenchmarkPolynomial_Exp_32(b *testing.B) {
 		poly.Exp(0x7fffffff, modulus)
 	}
 }
+
+func TestPolynomial_square(t *testing.T) {
+	for _, p := range []Polynomial{0, 1, 0b10, 0b1011, 0xFFFFFFFF, 0x80000001, 0x12345678} {
+		if square := p.square(); square != p.Mul(p) {
+			t.Errorf("expected (%s)^2 = %s, got %s", p, p.Mul(p), square)
+		}
+	}
+}
+
+func TestPolynomial_GCD(t *testing.T) {
+	type TestCase struct {
+		A, B Polynomial
+		GCD  Polynomial
+	}
+
+	testCases := []TestCase{
+		{
+			A:   0b1011,
+			B:   0,
+			GCD: 0b1011,
+		},
+		{
+			A:   0b1011,
+			B:   0b111,
+			GCD: 1,
+		},
+		{
+			A:   0b101,  // (x + 1)^2
+			B:   0b1111, // (x + 1)(x^2 + 1)
+			GCD: 0b101,
+		},
+		{
+			A:   PrimePolynomialDegree8.Mul(PrimePolynomialDegree3),
+			B:   PrimePolynomialDegree8.Mul(PrimePolynomialDegree4),
+			GCD: PrimePolynomialDegree8,
+		},
+	}
+
+	for _, test := range testCases {
+		if gcd := test.A.GCD(test.B); gcd != test.GCD {
+			t.Errorf("expected gcd(%s, %s) = %s (got %s)", test.A, test.B, test.GCD, gcd)
+		}
+	}
+}
+
+func TestPolynomial_IsIrreducible(t *testing.T) {
+	type TestCase struct {
+		Poly        Polynomial
+		Irreducible bool
+		Primitive   bool
+		Period      uint64
+	}
+
+	testCases := []TestCase{
+		{Poly: 0b10, Irreducible: true, Primitive: false, Period: 0},
+		{Poly: 0b11, Irreducible: true, Primitive: true, Period: 1},
+		{Poly: 0b101, Irreducible: false, Primitive: false, Period: 2},
+		{Poly: 0b11111, Irreducible: true, Primitive: false, Period: 5},
+		{Poly: 0b10101, Irreducible: false, Primitive: false, Period: 6},
+		{Poly: 0b100011011, Irreducible: true, Primitive: false, Period: 51},
+		{Poly: 0b100011101, Irreducible: true, Primitive: true, Period: 255},
+		{Poly: PrimePolynomialDegree8.Mul(PrimePolynomialDegree3), Irreducible: false, Primitive: false, Period: 1785},
+		{Poly: PrimePolynomialDegree16.Mul(PrimePolynomialDegree16), Irreducible: false, Primitive: false},
+	}
+
+	for _, test := range testCases {
+		if irreducible := test.Poly.IsIrreducible(); irreducible != test.Irreducible {
+			t.Errorf("expected %s irreducible = %v", test.Poly, test.Irreducible)
+		}
+		if primitive := test.Poly.IsPrimitive(); primitive != test.Primitive {
+			t.Errorf("expected %s primitive = %v", test.Poly, test.Primitive)
+		}
+		if test.Period == 0 && test.Poly&1 == 1 {
+			continue
+		}
+		if period := test.Poly.Period(); period != test.Period {
+			t.Errorf("expected period of %s = %d (got %d)", test.Poly, test.Period, period)
+		}
+	}
+}
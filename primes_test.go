@@ -205,5 +205,8 @@ func TestPrimes(t *testing.T) {
 		if order := fieldOrder(test.PrimePolynomial); order != test.Order {
 			t.Errorf("polynomial did not return expected order; wanted %d, got %d", test.Order, order)
 		}
+		if !test.PrimePolynomial.IsPrimitive() {
+			t.Errorf("polynomial %q is not primitive", test.PrimePolynomial)
+		}
 	}
 }
@@ -0,0 +1,106 @@
+package galois
+
+import "math/bits"
+
+// maxSparseTerms is the largest number of terms a prime polynomial may have for
+// NewField to reduce products modulo it by shifting and adding, rather than by
+// long division. This includes trinomials and pentanomials, which are used by
+// every prime polynomial shipped with this package, and by the NIST binary curves.
+const maxSparseTerms = 5
+
+// sparseReduction holds the terms of a sparse prime polynomial x^m + x^k1 + ...,
+// used to reduce polynomials modulo the prime a whole word at a time.
+type sparseReduction struct {
+	// prime is the polynomial the reduction was prepared for, so that a Field
+	// whose Prime is changed after construction falls back to Polynomial.Mod.
+	prime  Polynomial
+	degree uint64
+
+	// shifts lists the exponents k of the terms of prime below x^m. Unused
+	// entries have a keep mask of zero, so that reduce can add every term
+	// without branching.
+	shifts [maxSparseTerms - 1]uint64
+	keep   [maxSparseTerms - 1]Polynomial
+	nTerms int
+}
+
+// newSparseReduction prepares a sparseReduction for the given prime. Returns
+// false if the prime has more than maxSparseTerms terms, or has degree zero.
+func newSparseReduction(prime Polynomial) (sparseReduction, bool) {
+	if prime.Degree() == 0 || bits.OnesCount64(uint64(prime)) > maxSparseTerms {
+		return sparseReduction{}, false
+	}
+
+	reduction := sparseReduction{
+		prime:  prime,
+		degree: prime.Degree(),
+	}
+	lower := uint64(prime) &^ (1 << reduction.degree)
+	for lower != 0 {
+		reduction.shifts[reduction.nTerms] = uint64(bits.TrailingZeros64(lower))
+		reduction.keep[reduction.nTerms] = ^Polynomial(0)
+		reduction.nTerms++
+		lower &= lower - 1
+	}
+	return reduction, true
+}
+
+// reduce returns p modulo the prime. Since x^m = x^k1 + x^k2 + ... modulo the
+// prime, the part of p of degree m and above, h * x^m, can be replaced by
+// h * (x^k1 + x^k2 + ...). Each replacement lowers the degree of p by at least
+// m - k1, where k1 is the largest of the lower exponents, so only a few are
+// needed when the prime's middle terms have low degree.
+func (reduction *sparseReduction) reduce(p Polynomial) Polynomial {
+	m := reduction.degree
+	s0, s1, s2, s3 := reduction.shifts[0], reduction.shifts[1], reduction.shifts[2], reduction.shifts[3]
+	k0, k1, k2, k3 := reduction.keep[0], reduction.keep[1], reduction.keep[2], reduction.keep[3]
+	mask := Polynomial(1)<<m - 1
+
+	for high := p >> m; high != 0; high = p >> m {
+		p = (p & mask) ^
+			(high<<(s0&63))&k0 ^
+			(high<<(s1&63))&k1 ^
+			(high<<(s2&63))&k2 ^
+			(high<<(s3&63))&k3
+	}
+	return p
+}
+
+// reduce returns the polynomial p modulo the field's prime polynomial. If the
+// field was created by NewField with a sparse prime, this uses word-level
+// shift-and-add reduction, and otherwise it uses Polynomial.Mod.
+func (field *Field[T]) reduce(p Polynomial) Polynomial {
+	if field.sparse.prime == field.Prime && field.sparse.nTerms > 0 {
+		return field.sparse.reduce(p)
+	}
+	return p.Mod(field.Prime)
+}
+
+// mulMod returns the product of the polynomials a and b, modulo the field's prime.
+func (field *Field[T]) mulMod(a, b Polynomial) Polynomial {
+	return field.reduce(a.Mul(b))
+}
+
+// squareMod returns the square of the polynomial p, modulo the field's prime.
+// Polynomial.square only handles polynomials of degree 31 or less, so larger
+// polynomials, which occur in fields of degree greater than 32, are squared with
+// Polynomial.Mul, which panics if the square overflows, as Field.Mul does.
+func (field *Field[T]) squareMod(p Polynomial) Polynomial {
+	if p>>32 != 0 {
+		return field.reduce(p.Mul(p))
+	}
+	return field.reduce(p.square())
+}
+
+// exp raises base to the given power modulo the field's prime, using the square
+// and multiply algorithm. If exponent is zero, exp returns 1.
+func (field *Field[T]) exp(base Polynomial, exponent uint64) Polynomial {
+	result := Polynomial(1)
+	for i := bits.Len64(exponent) - 1; i >= 0; i-- {
+		result = field.squareMod(result)
+		if (exponent>>i)&1 == 1 {
+			result = field.mulMod(result, base)
+		}
+	}
+	return result
+}
@@ -0,0 +1,147 @@
+package galois
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestSparseReduction(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, prime := range append(benchmarkPrimes, PrimePolynomialDegree2, PrimePolynomialDegree5, 0x11B, 0b11) {
+		reduction, ok := newSparseReduction(prime)
+		if !ok {
+			t.Errorf("expected %s to be treated as sparse", prime)
+			continue
+		}
+
+		m := prime.Degree()
+		for trial := 0; trial < 1000; trial++ {
+			// Products of two field elements have degree at most 2m - 2.
+			p := Polynomial(rng.Uint64() >> (65 - 2*m))
+			if actual, expected := reduction.reduce(p), p.Mod(prime); actual != expected {
+				t.Fatalf("expected %x mod %s = %x, got %x", uint64(p), prime, uint64(expected), uint64(actual))
+			}
+		}
+	}
+
+	// x^8 + x^7 + x^6 + x^5 + x^4 + x^2 + 1 has too many terms.
+	if _, ok := newSparseReduction(0b111110101); ok {
+		t.Errorf("expected dense polynomial not to be treated as sparse")
+	}
+}
+
+func TestField_SparseMatchesGeneric(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, prime := range benchmarkPrimes {
+		sparse := NewField[uint32](prime)
+		generic := &Field[uint32]{Prime: prime}
+		mask := uint32(sparse.Order() - 1)
+
+		for trial := 0; trial < 200; trial++ {
+			a, b := rng.Uint32()&mask, rng.Uint32()&mask|1
+			e := rng.Uint64()
+
+			if sparse.Mul(a, b) != generic.Mul(a, b) {
+				t.Fatalf("expected products of %d and %d in GF(2^%d) to match", a, b, prime.Degree())
+			}
+			if sparse.Div(a, b) != generic.Div(a, b) {
+				t.Fatalf("expected quotients of %d and %d in GF(2^%d) to match", a, b, prime.Degree())
+			}
+			if sparse.Exp(b, e) != generic.Exp(b, e) {
+				t.Fatalf("expected %d^%d in GF(2^%d) to match", b, e, prime.Degree())
+			}
+		}
+	}
+}
+
+func TestField_SparseChangedPrime(t *testing.T) {
+	field := NewField[uint8](PrimePolynomialDegree8)
+	field.Log(2)
+	field.Prime = 0x11B
+
+	for a := Polynomial(0); a < 256; a++ {
+		expected := Polynomial(0x53).Mul(a).Mod(0x11B)
+		if actual := field.Mul(uint8(a), 0x53); actual != uint8(expected) {
+			t.Fatalf("expected %#x * 0x53 = %#x after changing prime, got %#x", uint64(a), uint64(expected), actual)
+		}
+	}
+	if actual, expected := field.Exp(3, 5), Polynomial(3).Exp(5, 0x11B); actual != uint8(expected) {
+		t.Errorf("expected 3^5 = %#x after changing prime, got %#x", uint64(expected), actual)
+	}
+
+	// x has order 51 modulo 0x11B, rather than 255 modulo the original prime, so
+	// the logarithm of x^60 is 9.
+	if log, err := field.Log(uint8(Generator.Exp(60, 0x11B))); err != nil || log != 9 {
+		t.Errorf("expected log(x^60) = 9 after changing prime, got %d, %v", log, err)
+	}
+}
+
+func TestField_ExpLargeDegree(t *testing.T) {
+	// x^33 + x^13 + 1 has degree too large for products of every element to fit
+	// in a Polynomial. Exp must panic on overflow, as Mul does, rather than
+	// silently truncating squares.
+	field := NewField[uint64](1<<33 | 1<<13 | 1)
+
+	if actual, expected := field.Exp(0x12345, 2), field.Mul(0x12345, 0x12345); actual != expected {
+		t.Errorf("expected 0x12345^2 = %#x, got %#x", expected, actual)
+	}
+
+	for name, fn := range map[string]func(){
+		"Mul": func() { field.Mul(1<<32|1, 1<<32|1) },
+		"Exp": func() { field.Exp(1<<32|1, 2) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected %s to panic when squaring an element of degree 32", name)
+				}
+			}()
+			fn()
+		}()
+	}
+}
+
+func BenchmarkField_Mul(b *testing.B) {
+	for _, prime := range benchmarkPrimes {
+		sparse := NewField[uint32](prime)
+		generic := &Field[uint32]{Prime: prime}
+
+		x := uint32(0x5A5A5A5A & (sparse.Order() - 1))
+		y := uint32(0xC3C3C3C3 & (sparse.Order() - 1))
+
+		b.Run(fmt.Sprintf("Generic/%d", prime.Degree()), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				generic.Mul(x, y)
+			}
+		})
+		b.Run(fmt.Sprintf("Sparse/%d", prime.Degree()), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sparse.Mul(x, y)
+			}
+		})
+	}
+}
+
+func BenchmarkField_Reduce(b *testing.B) {
+	for _, prime := range benchmarkPrimes {
+		sparse := NewField[uint32](prime)
+		generic := &Field[uint32]{Prime: prime}
+
+		// A product with about half of its 2m - 1 coefficients set.
+		p := Polynomial(0x5A5A5A5A5A5A5A5A) >> (65 - 2*prime.Degree())
+
+		b.Run(fmt.Sprintf("Generic/%d", prime.Degree()), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				generic.reduce(p)
+			}
+		})
+		b.Run(fmt.Sprintf("Sparse/%d", prime.Degree()), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sparse.reduce(p)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package galois
+
+// Sqrt returns the square root of the element a. Every element of GF(2^m)
+// has exactly one square root, which is a^(2^(m-1)).
+func (field *Field[T]) Sqrt(a T) T {
+	field.check(a)
+	return field.Frobenius(a, field.Prime.Degree()-1)
+}
+
+// HalfTrace returns the half-trace of the element a, which is the sum of
+// a^(2^(2i)) for i from 0 to (m-1)/2. If m is odd and Trace(a) is zero, the
+// half-trace h is a solution to h^2 + h = a.
+//
+// Panics if the degree m of the field's prime polynomial is even.
+func (field *Field[T]) HalfTrace(a T) T {
+	field.check(a)
+	degree := field.Prime.Degree()
+	if degree%2 == 0 {
+		panic("half-trace is only defined for fields of odd degree")
+	}
+
+	sum := a
+	for i := uint64(1); i <= (degree-1)/2; i++ {
+		a = field.Frobenius(a, 2)
+		sum = field.Add(sum, a)
+	}
+	return sum
+}
+
+// SolveQuadratic solves the equation a*x^2 + b*x + c = 0, and returns its
+// two roots. If the equation has a repeated root, x0 and x1 are equal. Returns
+// false if there are no roots in the field, or if a and b are both zero.
+//
+// When a and b are non-zero, the equation is transformed into y^2 + y = d,
+// where x = y*b/a and d = a*c/b^2. This has a solution if and only if Trace(d)
+// is zero. For fields of odd degree, the solution is the half-trace of d. For
+// fields of even degree, it is found using an element of trace one, as described
+// in IEEE 1363 A.4.7.
+func (field *Field[T]) SolveQuadratic(a, b, c T) (x0, x1 T, ok bool) {
+	field.check(a, b, c)
+	if a == 0 {
+		if b == 0 {
+			return 0, 0, false
+		}
+		// b*x = c
+		x := field.Div(c, b)
+		return x, x, true
+	} else if b == 0 {
+		// a*x^2 = c
+		x := field.Sqrt(field.Div(c, a))
+		return x, x, true
+	}
+
+	bOverA := field.Div(b, a)
+	d := field.Div(field.Mul(a, c), field.Mul(b, b))
+	if field.Trace(d) != 0 {
+		return 0, 0, false
+	}
+
+	y := field.solveArtinSchreier(d)
+	x0 = field.Mul(y, bOverA)
+	x1 = field.Mul(field.Add(y, 1), bOverA)
+	return x0, x1, true
+}
+
+// solveArtinSchreier returns a solution y to y^2 + y = d, where Trace(d) is zero.
+// The other solution is y + 1.
+func (field *Field[T]) solveArtinSchreier(d T) T {
+	degree := field.Prime.Degree()
+	if degree%2 == 1 {
+		return field.HalfTrace(d)
+	}
+
+	// Find an element tau with trace one.
+	tau := T(1)
+	for field.Trace(tau) != 1 {
+		tau++
+	}
+
+	z := T(0)
+	w := d
+	for i := uint64(1); i < degree; i++ {
+		w2 := field.Mul(w, w)
+		z = field.Add(field.Mul(z, z), field.Mul(w2, tau))
+		w = field.Add(w2, d)
+	}
+	return z
+}
+
+// CubeRoot returns a cube root of the element a, or false if a has no cube root.
+// If the degree m of the field's prime polynomial is odd, every element has
+// exactly one cube root. Otherwise, a third of the non-zero elements have three
+// cube roots each, and the rest have none.
+func (field *Field[T]) CubeRoot(a T) (T, bool) {
+	field.check(a)
+	return field.NthRoot(a, 3)
+}
+
+// NthRoot returns an element x such that x^n = a, or false if no such element
+// exists. When n has a common factor d with 2^m - 1, every element with an n-th
+// root has d of them; NthRoot returns only one. The others are found by
+// multiplying it by the d-th roots of unity.
+//
+// If n is coprime with 2^m - 1, the root is a^k, where k is the inverse of n
+// modulo 2^m - 1. Otherwise the problem reduces to taking roots of prime order
+// q dividing 2^m - 1, which are computed with the Adleman-Manders-Miller
+// algorithm.
+func (field *Field[T]) NthRoot(a T, n uint64) (T, bool) {
+	field.check(a)
+	if n == 0 {
+		return 1, a == 1
+	} else if a == 0 {
+		return 0, true
+	}
+
+	groupOrder := field.Order() - 1
+	n %= groupOrder
+	if n == 0 {
+		return 1, a == 1
+	}
+
+	// s*n = d mod (2^m - 1). If y^d = a, then (y^s)^n = a.
+	d, s := bezout(n, groupOrder)
+	if field.Exp(a, groupOrder/d) != 1 {
+		return 0, false
+	}
+
+	y := a
+	for _, q := range primeFactors(d) {
+		for rem := d; rem%q == 0; rem /= q {
+			y = field.primeRoot(y, q)
+		}
+	}
+	return field.Exp(y, s), true
+}
+
+// primeRoot returns a q-th root of a, where q is a prime dividing 2^m - 1, and
+// a is known to have a q-th root, using the Adleman-Manders-Miller algorithm.
+func (field *Field[T]) primeRoot(a T, q uint64) T {
+	groupOrder := field.Order() - 1
+
+	// 2^m - 1 = q^t * s, where s is coprime with q.
+	t := uint64(0)
+	s := groupOrder
+	for s%q == 0 {
+		s /= q
+		t++
+	}
+
+	// alpha is chosen so that s divides q*alpha - 1.
+	alpha := uint64(1)
+	if s > 1 {
+		_, alpha = bezout(q, s)
+	}
+
+	// Find an element rho which has no q-th root.
+	rho := T(2)
+	for field.Exp(rho, groupOrder/q) == 1 {
+		rho++
+	}
+
+	qPowT1 := groupOrder / s / q // q^(t-1)
+	unity := field.Exp(rho, qPowT1*s)
+	b := field.Exp(a, q*alpha-1)
+	c := field.Exp(rho, s)
+	h := T(1)
+
+	// b starts as a^(q*alpha - 1), whose order divides q^(t-1). Each iteration
+	// multiplies b by some (c^q)^j and h by c^j, reducing the order of b by a
+	// factor of q, until b is one and h^q is the inverse of a^(q*alpha - 1).
+	for i := uint64(1); i < t; i++ {
+		qPow := uint64(1)
+		for k := uint64(0); k < t-1-i; k++ {
+			qPow *= q
+		}
+		d := field.Exp(b, qPow)
+
+		if d != 1 {
+			// Find k with unity^k = d by brute force. q is at most the square root
+			// of 2^m - 1 here, because q^2 divides it.
+			k := uint64(1)
+			for power := unity; power != d; power = field.Mul(power, unity) {
+				k++
+			}
+			j := q - k
+
+			b = field.Mul(b, field.Exp(field.Exp(c, q), j))
+			h = field.Mul(h, field.Exp(c, j))
+		}
+		c = field.Exp(c, q)
+	}
+
+	// (a^alpha * h)^q = a * a^(q*alpha - 1) * h^q = a.
+	return field.Mul(field.Exp(a, alpha), h)
+}
@@ -0,0 +1,131 @@
+package galois
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestField_Sqrt(t *testing.T) {
+	for _, prime := range []Polynomial{PrimePolynomialDegree7, PrimePolynomialDegree8, PrimePolynomialDegree11} {
+		field := NewField[uint16](prime)
+		for a := uint16(0); uint64(a) < field.Order(); a++ {
+			root := field.Sqrt(a)
+			if square := field.Mul(root, root); square != a {
+				t.Errorf("expected sqrt(%d)^2 = %d in GF(2^%d), got %d", a, a, prime.Degree(), square)
+			}
+		}
+	}
+}
+
+func TestField_SolveQuadratic(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, prime := range []Polynomial{PrimePolynomialDegree5, PrimePolynomialDegree6, PrimePolynomialDegree8} {
+		field := NewField[uint8](prime)
+		order := int(field.Order())
+
+		for trial := 0; trial < 300; trial++ {
+			a, b, c := uint8(rng.Intn(order)), uint8(rng.Intn(order)), uint8(rng.Intn(order))
+
+			eval := func(x uint8) uint8 {
+				return field.Add(field.Mul(a, x, x), field.Mul(b, x), c)
+			}
+
+			var roots []uint8
+			for x := 0; x < order; x++ {
+				if eval(uint8(x)) == 0 {
+					roots = append(roots, uint8(x))
+				}
+			}
+
+			x0, x1, ok := field.SolveQuadratic(a, b, c)
+			if a == 0 && b == 0 {
+				if ok {
+					t.Errorf("expected no solution to degenerate equation %d = 0", c)
+				}
+				continue
+			}
+
+			if ok != (len(roots) > 0) {
+				t.Errorf(
+					"GF(2^%d): %d*x^2 + %d*x + %d = 0 has roots %v, but SolveQuadratic returned ok = %v",
+					prime.Degree(), a, b, c, roots, ok,
+				)
+				continue
+			}
+			if ok && (eval(x0) != 0 || eval(x1) != 0) {
+				t.Errorf(
+					"GF(2^%d): %d and %d are not roots of %d*x^2 + %d*x + %d",
+					prime.Degree(), x0, x1, a, b, c,
+				)
+			}
+			if ok && len(roots) == 2 && x0 == x1 {
+				t.Errorf("expected two distinct roots %v, got %d twice", roots, x0)
+			}
+		}
+	}
+}
+
+func TestField_NthRoot(t *testing.T) {
+	type TestCase struct {
+		Prime     Polynomial
+		Exponents []uint64
+	}
+
+	testCases := []TestCase{
+		{
+			Prime:     PrimePolynomialDegree6, // 2^6 - 1 = 3^2 * 7
+			Exponents: []uint64{0, 1, 2, 3, 7, 9, 21, 27, 63, 64, 126, 189},
+		},
+		{
+			Prime:     PrimePolynomialDegree8, // 2^8 - 1 = 3 * 5 * 17
+			Exponents: []uint64{3, 5, 15, 17, 51, 85, 255, 300},
+		},
+		{
+			Prime:     PrimePolynomialDegree10, // 2^10 - 1 = 3 * 11 * 31
+			Exponents: []uint64{3, 33, 93, 1 << 40},
+		},
+	}
+
+	for _, test := range testCases {
+		field := NewField[uint16](test.Prime)
+
+		for _, n := range test.Exponents {
+			powers := make(map[uint16]bool)
+			for x := uint16(0); uint64(x) < field.Order(); x++ {
+				power := field.Exp(x, n)
+				if x == 0 && n > 0 {
+					power = 0
+				}
+				powers[power] = true
+			}
+
+			for a := uint16(0); uint64(a) < field.Order(); a++ {
+				root, ok := field.NthRoot(a, n)
+				if ok != powers[a] {
+					t.Errorf("GF(2^%d): expected %d-th root of %d to exist = %v", test.Prime.Degree(), n, a, powers[a])
+					continue
+				}
+
+				power := field.Exp(root, n)
+				if root == 0 && n > 0 {
+					power = 0
+				}
+				if ok && power != a {
+					t.Errorf("GF(2^%d): expected %d^%d = %d, got %d", test.Prime.Degree(), root, n, a, power)
+				}
+			}
+		}
+	}
+}
+
+func TestField_CubeRoot(t *testing.T) {
+	// Every element of a field of odd degree has a unique cube root.
+	field := NewField[uint16](PrimePolynomialDegree11)
+	for a := uint16(0); uint64(a) < field.Order(); a++ {
+		root, ok := field.CubeRoot(a)
+		if !ok || field.Mul(root, root, root) != a {
+			t.Errorf("expected %d to have a cube root in GF(2^11); got %d, %v", a, root, ok)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Package scrambler implements multiplicative (self-synchronizing) and additive
+// (frame-synchronous) scramblers, whose feedback is defined by a galois.Polynomial.
+//
+// Scramblers whiten a bit stream, so that long runs of ones or zeros are unlikely,
+// without adding any overhead. Descrambling with the same configuration restores
+// the original stream.
+package scrambler
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/kklash/galois"
+	"github.com/kklash/galois/lfsr"
+)
+
+// Mode selects how a scrambler combines its feedback register with the data.
+type Mode int
+
+const (
+	// Multiplicative scramblers divide the data stream by the feedback polynomial,
+	// feeding their own output back into the register. The descrambler feeds the
+	// received bits into its register, so it synchronizes itself after n bits, but
+	// each bit error in transit is multiplied by the number of terms in the
+	// polynomial.
+	Multiplicative Mode = iota
+
+	// Additive scramblers XOR the data stream with the output of a Fibonacci
+	// register which runs independently of the data. The descrambler must be
+	// started in the same state as the scrambler, usually at the start of each
+	// frame, but bit errors in transit are not multiplied.
+	Additive
+)
+
+// Config describes a scrambler.
+type Config struct {
+	// Mode selects a multiplicative or additive scrambler.
+	Mode Mode
+
+	// Poly is the feedback polynomial. Each term x^k taps the k-th previous bit, in
+	// the same manner as an lfsr.Fibonacci register.
+	Poly galois.Polynomial
+
+	// Seed is the initial state of the register. For additive scramblers, this is
+	// the state of an lfsr.Fibonacci register, which holds its next n output bits.
+	// For multiplicative scramblers, bit k-1 holds the k-th previous output bit.
+	Seed uint64
+
+	// LSBFirst indicates that the bits of each byte are processed starting from the
+	// least-significant bit, rather than the most-significant bit.
+	LSBFirst bool
+}
+
+// Standard scrambler configurations.
+var (
+	// Ethernet64b66b is the self-synchronizing scrambler of IEEE 802.3 64b/66b
+	// encoding, x^58 + x^39 + 1, seeded with all ones. It scrambles the 64 payload
+	// bits of each block, least-significant bit first.
+	Ethernet64b66b = Config{
+		Mode:     Multiplicative,
+		Poly:     1<<58 | 1<<39 | 1,
+		Seed:     1<<58 - 1,
+		LSBFirst: true,
+	}
+
+	// SONET is the frame-synchronous scrambler of SONET/SDH, x^7 + x^6 + 1, which is
+	// reset to all ones at the start of each frame.
+	SONET = Config{
+		Mode: Additive,
+		Poly: 1<<7 | 1<<6 | 1,
+		Seed: 1<<7 - 1,
+	}
+
+	// DVB is the energy-dispersal randomizer of DVB-S and DVB-T, x^15 + x^14 + 1.
+	// The standard loads the register with 100101010000000 at the start of each
+	// group of eight packets, and takes its output from the feedback bit. The
+	// equivalent lfsr.Fibonacci state is that register advanced by 15 steps.
+	DVB = Config{
+		Mode: Additive,
+		Poly: 1<<15 | 1<<14 | 1,
+		Seed: 0x1FB,
+	}
+
+	// IEEE80211 is the frame-synchronous data scrambler of IEEE 802.11 OFDM,
+	// x^7 + x^4 + 1, which processes data least-significant bit first. The standard
+	// takes its output from the feedback bit, and chooses a pseudo-random initial
+	// state for each frame. This preset uses the state equivalent to a register of
+	// all ones, which produces the sequence listed in the standard.
+	IEEE80211 = Config{
+		Mode:     Additive,
+		Poly:     1<<7 | 1<<4 | 1,
+		Seed:     0b0000111,
+		LSBFirst: true,
+	}
+)
+
+// Scrambler scrambles or descrambles a stream of data. Create one using
+// Config.NewScrambler or Config.NewDescrambler.
+type Scrambler struct {
+	config     Config
+	descramble bool
+
+	// Used by multiplicative scramblers.
+	taps  uint64
+	mask  uint64
+	state uint64
+
+	// Used by additive scramblers.
+	register *lfsr.Fibonacci
+}
+
+// NewScrambler returns a Scrambler which scrambles data according to config.
+//
+// Panics if the degree of config.Poly is zero or greater than 63, or if it has
+// no constant term.
+func (config Config) NewScrambler() *Scrambler {
+	return config.newScrambler(false)
+}
+
+// NewDescrambler returns a Scrambler which reverses the scrambling of a Scrambler
+// created with NewScrambler.
+//
+// Panics if the degree of config.Poly is zero or greater than 63, or if it has
+// no constant term.
+func (config Config) NewDescrambler() *Scrambler {
+	return config.newScrambler(true)
+}
+
+func (config Config) newScrambler(descramble bool) *Scrambler {
+	degree := config.Poly.Degree()
+	if degree == 0 || degree > 63 || config.Poly&1 == 0 {
+		panic(fmt.Sprintf("scrambler: invalid feedback polynomial %s", config.Poly))
+	}
+
+	s := &Scrambler{
+		config:     config,
+		descramble: descramble,
+	}
+
+	switch config.Mode {
+	case Multiplicative:
+		s.mask = 1<<degree - 1
+		s.taps = uint64(config.Poly>>1) & s.mask
+		s.state = config.Seed & s.mask
+	case Additive:
+		s.register = lfsr.NewFibonacci(config.Poly, config.Seed)
+	default:
+		panic(fmt.Sprintf("scrambler: unknown mode %d", config.Mode))
+	}
+	return s
+}
+
+// Bit scrambles or descrambles a single bit, either zero or one.
+func (s *Scrambler) Bit(in uint8) uint8 {
+	in &= 1
+	if s.register != nil {
+		return in ^ s.register.Bit()
+	}
+
+	out := in ^ uint8(bits.OnesCount64(s.state&s.taps)&1)
+	if s.descramble {
+		s.state = (s.state<<1 | uint64(in)) & s.mask
+	} else {
+		s.state = (s.state<<1 | uint64(out)) & s.mask
+	}
+	return out
+}
+
+// Byte scrambles or descrambles the eight bits of a byte, in the order given
+// by the LSBFirst field of the Scrambler's Config.
+func (s *Scrambler) Byte(in byte) (out byte) {
+	for i := 0; i < 8; i++ {
+		if s.config.LSBFirst {
+			out |= s.Bit(in>>i) << i
+		} else {
+			out |= s.Bit(in>>(7-i)) << (7 - i)
+		}
+	}
+	return
+}
+
+// Transform scrambles or descrambles the bytes of src into dst, which must be at
+// least as long as src. dst and src may overlap entirely, but not partially.
+func (s *Scrambler) Transform(dst, src []byte) {
+	if len(dst) < len(src) {
+		panic("scrambler: output smaller than input")
+	}
+	for i, b := range src {
+		dst[i] = s.Byte(b)
+	}
+}
@@ -0,0 +1,102 @@
+package scrambler
+
+import (
+	"bytes"
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+var presets = map[string]Config{
+	"Ethernet64b66b": Ethernet64b66b,
+	"SONET":          SONET,
+	"DVB":            DVB,
+	"IEEE80211":      IEEE80211,
+}
+
+func TestScrambler_RoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	data := make([]byte, 1000)
+	rng.Read(data)
+
+	for name, config := range presets {
+		scrambled := make([]byte, len(data))
+		config.NewScrambler().Transform(scrambled, data)
+		if bytes.Equal(scrambled, data) {
+			t.Errorf("%s: scrambling did not change data", name)
+		}
+
+		descrambled := make([]byte, len(data))
+		config.NewDescrambler().Transform(descrambled, scrambled)
+		if !bytes.Equal(descrambled, data) {
+			t.Errorf("%s: descrambling did not restore data", name)
+		}
+	}
+}
+
+func TestScrambler_Keystream(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		Config   Config
+		Expected []byte
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "SONET",
+			Config:   SONET,
+			Expected: []byte{0xFE, 0x04, 0x18, 0x51},
+		},
+		{
+			Name:     "DVB",
+			Config:   DVB,
+			Expected: []byte{0x03, 0xF6, 0x08},
+		},
+		{
+			// The sequence 00001110 11110010 11001001, packed least-significant bit first.
+			Name:     "IEEE80211",
+			Config:   IEEE80211,
+			Expected: []byte{0x70, 0x4F, 0x93},
+		},
+	}
+
+	for _, test := range testCases {
+		keystream := make([]byte, len(test.Expected))
+		test.Config.NewScrambler().Transform(keystream, keystream)
+		if !bytes.Equal(keystream, test.Expected) {
+			t.Errorf("%s: expected keystream %X, got %X", test.Name, test.Expected, keystream)
+		}
+	}
+}
+
+func TestScrambler_SelfSynchronizing(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	data := make([]byte, 100)
+	rng.Read(data)
+
+	scrambled := make([]byte, len(data))
+	Ethernet64b66b.NewScrambler().Transform(scrambled, data)
+
+	// A descrambler with the wrong seed recovers the data once the first 58
+	// received bits have filled its register.
+	config := Ethernet64b66b
+	config.Seed = 0x123456789
+	descrambled := make([]byte, len(data))
+	config.NewDescrambler().Transform(descrambled, scrambled)
+
+	if !bytes.Equal(descrambled[8:], data[8:]) {
+		t.Errorf("expected descrambler to synchronize after 58 bits")
+	}
+
+	// A single bit error in transit causes one error for each term of the polynomial.
+	scrambled[50] ^= 0x10
+	Ethernet64b66b.NewDescrambler().Transform(descrambled, scrambled)
+
+	errors := 0
+	for i := range data {
+		errors += bits.OnesCount8(data[i] ^ descrambled[i])
+	}
+	if errors != 3 {
+		t.Errorf("expected a single bit error to cause 3 errors after descrambling, got %d", errors)
+	}
+}
@@ -0,0 +1,47 @@
+package scrambler
+
+import "io"
+
+// Reader scrambles or descrambles the data read from an underlying io.Reader.
+type Reader struct {
+	r io.Reader
+	s *Scrambler
+}
+
+// NewReader returns a Reader which transforms data read from r with s.
+func NewReader(r io.Reader, s *Scrambler) *Reader {
+	return &Reader{r: r, s: s}
+}
+
+// Read reads from the underlying io.Reader, and transforms the data in place.
+func (reader *Reader) Read(buf []byte) (int, error) {
+	n, err := reader.r.Read(buf)
+	reader.s.Transform(buf[:n], buf[:n])
+	return n, err
+}
+
+// Writer scrambles or descrambles data before writing it to an underlying io.Writer.
+type Writer struct {
+	w   io.Writer
+	s   *Scrambler
+	buf []byte
+}
+
+// NewWriter returns a Writer which transforms data with s before writing it to w.
+func NewWriter(w io.Writer, s *Scrambler) *Writer {
+	return &Writer{w: w, s: s}
+}
+
+// Write transforms buf and writes the result to the underlying io.Writer. buf
+// itself is not modified.
+//
+// The Scrambler advances over all of buf, even if the underlying io.Writer fails
+// to write all of it.
+func (writer *Writer) Write(buf []byte) (int, error) {
+	if cap(writer.buf) < len(buf) {
+		writer.buf = make([]byte, len(buf))
+	}
+	out := writer.buf[:len(buf)]
+	writer.s.Transform(out, buf)
+	return writer.w.Write(out)
+}
@@ -0,0 +1,44 @@
+package scrambler
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+func TestReaderWriter(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	data := make([]byte, 4096)
+	rng.Read(data)
+
+	for name, config := range presets {
+		var scrambled bytes.Buffer
+		w := NewWriter(&scrambled, config.NewScrambler())
+
+		// Write in uneven chunks to check that state carries across calls.
+		for i := 0; i < len(data); i += 333 {
+			end := i + 333
+			if end > len(data) {
+				end = len(data)
+			}
+			if _, err := w.Write(data[i:end]); err != nil {
+				t.Fatalf("%s: failed to write: %s", name, err)
+			}
+		}
+
+		expected := make([]byte, len(data))
+		config.NewScrambler().Transform(expected, data)
+		if !bytes.Equal(scrambled.Bytes(), expected) {
+			t.Errorf("%s: streamed scrambling differs from Transform", name)
+		}
+
+		descrambled, err := io.ReadAll(NewReader(&scrambled, config.NewDescrambler()))
+		if err != nil {
+			t.Fatalf("%s: failed to read: %s", name, err)
+		}
+		if !bytes.Equal(descrambled, data) {
+			t.Errorf("%s: streamed descrambling did not restore data", name)
+		}
+	}
+}
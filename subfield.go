@@ -0,0 +1,168 @@
+package galois
+
+import "fmt"
+
+// SubfieldMap relates a Field GF(2^m) to its subfield GF(2^k), where k divides m.
+// The subfield is represented by its own Field of degree k, the Small field, and
+// the map embeds elements of Small into the Large field, and projects elements of
+// the subfield in Large back into Small. Embedding preserves addition and
+// multiplication.
+//
+// GF(2^m) is also a vector space of dimension m/k over GF(2^k). SubfieldMap can
+// convert elements of Large to and from vectors of Small elements, with respect
+// to the basis 1, x, x^2, ..., x^(m/k-1), where x is the Generator of Large.
+type SubfieldMap[T IntLike] struct {
+	// Small is the subfield GF(2^k).
+	Small *Field[T]
+
+	// Large is the field GF(2^m) which contains the subfield.
+	Large *Field[T]
+
+	embedding bitMatrix
+	projector *bitEchelon
+
+	vectorBasis   bitMatrix
+	vectorSolver  *bitEchelon
+	vectorLength  int
+	subfieldWidth uint64
+}
+
+// Subfield returns a map between this field and its subfield of degree k.
+//
+// The Small field's prime polynomial is the minimal polynomial of g^((2^m-1)/(2^k-1)),
+// where g is the first primitive element of the field, so that the Small field's
+// Generator corresponds to that element of this field.
+//
+// Panics if k is zero or does not divide the degree m of the field's prime polynomial.
+func (field *Field[T]) Subfield(k uint64) *SubfieldMap[T] {
+	m := field.Prime.Degree()
+	if k == 0 || m%k != 0 {
+		panic(fmt.Sprintf("GF(2^%d) has no subfield GF(2^%d)", m, k))
+	}
+
+	exponent := (field.Order() - 1) / (uint64(1)<<k - 1)
+	gamma := field.Exp(field.primitiveElement(), exponent)
+	small := NewField[T](field.MinimalPolynomial(gamma))
+
+	// The powers of gamma are the images of the Small field's polynomial basis.
+	embedding := make(bitMatrix, k)
+	power := T(1)
+	for i := range embedding {
+		embedding[i] = uint64(power)
+		power = field.Mul(power, gamma)
+	}
+	projector, ok := embedding.echelon()
+	if !ok {
+		panic(fmt.Sprintf("failed to embed GF(2^%d) in GF(2^%d)", k, m))
+	}
+
+	// Column i + k*j is the image of x^i in Small, multiplied by x^j in Large.
+	vectorLength := int(m / k)
+	vectorBasis := make(bitMatrix, 0, m)
+	xPower := T(1)
+	for j := 0; j < vectorLength; j++ {
+		for _, column := range embedding {
+			vectorBasis = append(vectorBasis, uint64(field.Mul(T(column), xPower)))
+		}
+		xPower = field.Mul(xPower, T(Generator))
+	}
+	vectorSolver, ok := vectorBasis.echelon()
+	if !ok {
+		panic(fmt.Sprintf("failed to find a basis of GF(2^%d) over GF(2^%d)", m, k))
+	}
+
+	return &SubfieldMap[T]{
+		Small:         small,
+		Large:         field,
+		embedding:     embedding,
+		projector:     projector,
+		vectorBasis:   vectorBasis,
+		vectorSolver:  vectorSolver,
+		vectorLength:  vectorLength,
+		subfieldWidth: k,
+	}
+}
+
+// primitiveElement returns the smallest element of the field which generates
+// every non-zero element. This is the Generator whenever the field's prime
+// polynomial is primitive.
+func (field *Field[T]) primitiveElement() T {
+	for a := T(Generator); uint64(a) < field.Order(); a++ {
+		if field.IsPrimitiveElement(a) {
+			return a
+		}
+	}
+
+	// GF(2) has only the element 1.
+	return 1
+}
+
+// Degree returns the degree k of the subfield.
+func (sm *SubfieldMap[T]) Degree() uint64 {
+	return sm.subfieldWidth
+}
+
+// Embed maps the element a of the Small field to the corresponding element of
+// the Large field.
+func (sm *SubfieldMap[T]) Embed(a T) T {
+	return T(sm.embedding.mulVec(uint64(a)))
+}
+
+// Contains returns true if the element b of the Large field lies in the subfield,
+// which is the case if and only if b^(2^k) = b.
+func (sm *SubfieldMap[T]) Contains(b T) bool {
+	return sm.Large.Frobenius(b, sm.subfieldWidth) == b
+}
+
+// Project maps the element b of the Large field back to the element of the
+// Small field which embeds as b. It is the inverse of Embed.
+//
+// Panics if b does not lie in the subfield.
+func (sm *SubfieldMap[T]) Project(b T) T {
+	a, ok := sm.projector.solve(uint64(b))
+	if !ok {
+		panic(
+			fmt.Sprintf(
+				"element %d of GF(2^%d) is not in the subfield GF(2^%d)",
+				b, sm.Large.Prime.Degree(), sm.subfieldWidth,
+			),
+		)
+	}
+	return T(a)
+}
+
+// ToVector returns the coordinates of the element b of the Large field, as a
+// vector of m/k elements of the Small field. If the vector is v, then b is the
+// sum of Embed(v[j]) * x^j.
+func (sm *SubfieldMap[T]) ToVector(b T) []T {
+	// Every element is in the span, because the vector basis is a full basis.
+	bits, _ := sm.vectorSolver.solve(uint64(b))
+
+	mask := uint64(1)<<sm.subfieldWidth - 1
+	vector := make([]T, sm.vectorLength)
+	for j := range vector {
+		vector[j] = T((bits >> (uint64(j) * sm.subfieldWidth)) & mask)
+	}
+	return vector
+}
+
+// FromVector returns the element of the Large field whose coordinates over the
+// Small field are given by vector. It is the inverse of ToVector.
+//
+// Panics if the vector does not have exactly m/k elements.
+func (sm *SubfieldMap[T]) FromVector(vector []T) T {
+	if len(vector) != sm.vectorLength {
+		panic(
+			fmt.Sprintf(
+				"expected vector of %d elements of GF(2^%d), got %d",
+				sm.vectorLength, sm.subfieldWidth, len(vector),
+			),
+		)
+	}
+
+	var bits uint64
+	for j, v := range vector {
+		bits |= uint64(v) << (uint64(j) * sm.subfieldWidth)
+	}
+	return T(sm.vectorBasis.mulVec(bits))
+}
@@ -0,0 +1,126 @@
+package galois
+
+import "testing"
+
+func TestField_Subfield(t *testing.T) {
+	type TestCase struct {
+		Prime Polynomial
+		K     uint64
+	}
+
+	testCases := []TestCase{
+		{PrimePolynomialDegree8, 1},
+		{PrimePolynomialDegree8, 2},
+		{PrimePolynomialDegree8, 4},
+		{PrimePolynomialDegree8, 8},
+		{0x11B, 4}, // AES, whose prime is not primitive
+		{PrimePolynomialDegree6, 2},
+		{PrimePolynomialDegree6, 3},
+		{PrimePolynomialDegree12, 3},
+		{PrimePolynomialDegree12, 4},
+		{PrimePolynomialDegree12, 6},
+	}
+
+	for _, tc := range testCases {
+		large := NewField[uint16](tc.Prime)
+		sm := large.Subfield(tc.K)
+
+		if sm.Small.Prime.Degree() != tc.K || !sm.Small.Prime.IsIrreducible() {
+			t.Errorf("expected irreducible subfield prime of degree %d, got %s", tc.K, sm.Small.Prime)
+			continue
+		}
+
+		embedded := make(map[uint16]bool)
+		for a := uint16(0); uint64(a) < sm.Small.Order(); a++ {
+			b := sm.Embed(a)
+			embedded[b] = true
+			if !sm.Contains(b) {
+				t.Errorf("expected Embed(%d) = %d to lie in GF(2^%d)", a, b, tc.K)
+			}
+			if projected := sm.Project(b); projected != a {
+				t.Errorf("expected Project(Embed(%d)) = %d, got %d", a, a, projected)
+			}
+
+			for c := uint16(0); uint64(c) < sm.Small.Order(); c++ {
+				if sm.Embed(sm.Small.Mul(a, c)) != large.Mul(b, sm.Embed(c)) {
+					t.Errorf("expected Embed to preserve multiplication of %d and %d", a, c)
+				}
+				if sm.Embed(sm.Small.Add(a, c)) != large.Add(b, sm.Embed(c)) {
+					t.Errorf("expected Embed to preserve addition of %d and %d", a, c)
+				}
+			}
+		}
+
+		var contained int
+		for b := uint16(0); uint64(b) < large.Order(); b++ {
+			if sm.Contains(b) {
+				contained++
+				if !embedded[b] {
+					t.Errorf("expected element %d in subfield to be the image of Embed", b)
+				}
+			}
+		}
+		if uint64(contained) != sm.Small.Order() {
+			t.Errorf("expected %d elements in subfield GF(2^%d), found %d", sm.Small.Order(), tc.K, contained)
+		}
+	}
+}
+
+func TestSubfieldMap_Vector(t *testing.T) {
+	large := NewField[uint16](PrimePolynomialDegree12)
+	sm := large.Subfield(4)
+
+	for b := uint16(0); uint64(b) < large.Order(); b++ {
+		vector := sm.ToVector(b)
+		if len(vector) != 3 {
+			t.Fatalf("expected vector of 3 elements, got %d", len(vector))
+		}
+
+		var sum uint16
+		for j, v := range vector {
+			if uint64(v) >= sm.Small.Order() {
+				t.Errorf("vector coordinate %d is outside GF(2^4)", v)
+			}
+			sum = large.Add(sum, large.Mul(sm.Embed(v), large.Generate(uint64(j))))
+		}
+		if sum != b {
+			t.Errorf("expected coordinates of %d to sum to itself, got %d", b, sum)
+		}
+		if back := sm.FromVector(vector); back != b {
+			t.Errorf("expected FromVector(ToVector(%d)) = %d, got %d", b, b, back)
+		}
+
+		// Coordinates are linear over the subfield.
+		const c uint16 = 7
+		scaled := sm.ToVector(large.Mul(sm.Embed(c), b))
+		for j := range vector {
+			if scaled[j] != sm.Small.Mul(c, vector[j]) {
+				t.Errorf("expected coordinates of %d*%d to be scaled by %d", c, b, c)
+			}
+		}
+	}
+}
+
+func TestField_Subfield_Panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected to panic when requesting GF(2^3) inside GF(2^8)")
+		}
+	}()
+	NewField[uint8](PrimePolynomialDegree8).Subfield(3)
+}
+
+func TestSubfieldMap_ProjectPanics(t *testing.T) {
+	sm := NewField[uint8](PrimePolynomialDegree8).Subfield(4)
+	for b := uint8(2); ; b++ {
+		if !sm.Contains(b) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected to panic when projecting %d outside the subfield", b)
+				}
+			}()
+			sm.Project(b)
+			return
+		}
+	}
+}
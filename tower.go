@@ -0,0 +1,291 @@
+package galois
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// TowerField is a composite field GF((2^n)^k), whose elements are polynomials of
+// degree less than k with coefficients in a Base Field GF(2^n), taken modulo an
+// irreducible polynomial of degree k over the Base field. Composite fields are
+// used in compact hardware implementations of the AES S-box, which work in
+// GF((2^4)^2), and in binary tower fields such as GF(2^(2^k)).
+//
+// An element of a TowerField is packed into a single integer of type T, with
+// coefficient i occupying bits i*n to (i+1)*n - 1. A TowerField has the same
+// order as the Field GF(2^(n*k)), and is isomorphic to it. See Isomorphism.
+type TowerField[T IntLike] struct {
+	base    *Field[T]
+	modulus FieldPolynomial[T]
+	degree  uint64
+	width   uint64
+}
+
+// NewTowerField creates a TowerField over the given base Field. The modulus is
+// a polynomial over the base field, with coefficients listed in ascending order
+// of degree, and is scaled to be monic.
+//
+// Panics if the modulus has degree zero or is not irreducible over the base
+// field, or if the type parameter T is not of sufficient size to represent every
+// element in the field.
+func NewTowerField[T IntLike](base *Field[T], modulus FieldPolynomial[T]) *TowerField[T] {
+	modulus = modulus.trim()
+	if len(modulus) < 2 {
+		panic("cannot create tower field with a constant modulus")
+	}
+
+	leadInverse := base.MultInverse(modulus[len(modulus)-1])
+	monic := make(FieldPolynomial[T], len(modulus))
+	for i, coeff := range modulus {
+		monic[i] = base.Mul(coeff, leadInverse)
+	}
+
+	tf := &TowerField[T]{
+		base:    base,
+		modulus: monic,
+		degree:  uint64(len(monic) - 1),
+		width:   base.Prime.Degree(),
+	}
+
+	bitLen := tf.degree * tf.width
+	if bitLen > 32 || getMaxTypeValue[T]() < uint64(1)<<bitLen-1 {
+		panic(
+			fmt.Sprintf(
+				"cannot use %T to represent elements of GF((2^%d)^%d)",
+				T(0), tf.width, tf.degree,
+			),
+		)
+	}
+
+	if !tf.isIrreducible() {
+		panic(fmt.Sprintf("modulus %v is not irreducible over GF(2^%d)", modulus, tf.width))
+	}
+
+	return tf
+}
+
+// isIrreducible applies Rabin's test of irreducibility to the modulus f of
+// degree k over GF(q): f is irreducible iff y^(q^k) = y mod f, and
+// y^(q^(k/p)) - y is coprime with f for every prime factor p of k.
+func (tf *TowerField[T]) isIrreducible() bool {
+	y := FieldPolynomial[T]{0, 1}
+
+	// frobeniusPowers[j] is y^(q^j) mod f.
+	frobeniusPowers := make([]FieldPolynomial[T], tf.degree+1)
+	frobeniusPowers[0] = tf.base.modPolynomials(y, tf.modulus)
+	for j := uint64(1); j <= tf.degree; j++ {
+		power := frobeniusPowers[j-1]
+		for i := uint64(0); i < tf.width; i++ {
+			power = tf.base.modPolynomials(tf.base.mulPolynomials(power, power), tf.modulus)
+		}
+		frobeniusPowers[j] = power
+	}
+
+	if len(tf.base.addPolynomials(frobeniusPowers[tf.degree], frobeniusPowers[0])) != 0 {
+		return false
+	}
+	for _, p := range primeFactors(tf.degree) {
+		difference := tf.base.addPolynomials(frobeniusPowers[tf.degree/p], y)
+		if len(tf.base.gcdPolynomials(difference, tf.modulus)) != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// Base returns the base Field GF(2^n) from which coefficients are drawn.
+func (tf *TowerField[T]) Base() *Field[T] {
+	return tf.base
+}
+
+// Modulus returns the monic irreducible polynomial over the base field which
+// defines the TowerField.
+func (tf *TowerField[T]) Modulus() FieldPolynomial[T] {
+	return append(FieldPolynomial[T](nil), tf.modulus...)
+}
+
+// Degree returns the degree k of the TowerField over its base field.
+func (tf *TowerField[T]) Degree() uint64 {
+	return tf.degree
+}
+
+// Order returns the order of the field (i.e. the number of elements, including zero).
+func (tf *TowerField[T]) Order() uint64 {
+	return uint64(1) << (tf.degree * tf.width)
+}
+
+// Pack converts a polynomial over the base field, of degree less than k, into
+// its packed representation as an element of the TowerField.
+//
+// Panics if the polynomial has degree k or more.
+func (tf *TowerField[T]) Pack(coeffs FieldPolynomial[T]) T {
+	coeffs = coeffs.trim()
+	if uint64(len(coeffs)) > tf.degree {
+		panic(fmt.Sprintf("cannot pack polynomial of degree %d into GF((2^%d)^%d)", coeffs.Degree(), tf.width, tf.degree))
+	}
+
+	var packed uint64
+	for i, coeff := range coeffs {
+		packed |= uint64(coeff) << (uint64(i) * tf.width)
+	}
+	return T(packed)
+}
+
+// Unpack returns the k coefficients of the element a, each an element of the
+// base field, in ascending order of degree.
+func (tf *TowerField[T]) Unpack(a T) FieldPolynomial[T] {
+	mask := uint64(1)<<tf.width - 1
+	coeffs := make(FieldPolynomial[T], tf.degree)
+	for i := range coeffs {
+		coeffs[i] = T((uint64(a) >> (uint64(i) * tf.width)) & mask)
+	}
+	return coeffs
+}
+
+// Add computes the sum of the given elements within the TowerField.
+func (tf *TowerField[T]) Add(values ...T) (sum T) {
+	for _, v := range values {
+		sum ^= v
+	}
+	return
+}
+
+// Sub computes the difference of the given elements (a - b), which is the same as
+// their sum.
+func (tf *TowerField[T]) Sub(a, b T) T {
+	return a ^ b
+}
+
+// Mul multiplies a set of elements and returns the product. If called with no
+// parameters, Mul returns zero.
+func (tf *TowerField[T]) Mul(values ...T) T {
+	if len(values) == 0 {
+		return 0
+	}
+	product := values[0]
+	for _, v := range values[1:] {
+		product = tf.mul(product, v)
+	}
+	return product
+}
+
+// mul multiplies two elements, as polynomials over the base field modulo the
+// modulus.
+func (tf *TowerField[T]) mul(a, b T) T {
+	product := tf.base.mulPolynomials(tf.Unpack(a), tf.Unpack(b))
+	return tf.Pack(tf.base.modPolynomials(product, tf.modulus))
+}
+
+// Exp multiplies the base element by itself the given number of times.
+//
+// If exponent is zero, returns the multiplicative identity 1.
+func (tf *TowerField[T]) Exp(base T, exponent uint64) T {
+	result := T(1)
+	for i := bits.Len64(exponent) - 1; i >= 0; i-- {
+		result = tf.mul(result, result)
+		if (exponent>>i)&1 == 1 {
+			result = tf.mul(result, base)
+		}
+	}
+	return result
+}
+
+// MultInverse computes the multiplicative inverse of a, as a^(Q-2), where Q is
+// the order of the field.
+//
+// Panics if a is the additive identity element zero.
+func (tf *TowerField[T]) MultInverse(a T) T {
+	if a == 0 {
+		panic("division by zero error")
+	}
+	return tf.Exp(a, tf.Order()-2)
+}
+
+// Div returns the division of the numerator element by the denominator element.
+//
+// Panics if denominator is the additive identity element zero.
+func (tf *TowerField[T]) Div(numerator, denominator T) T {
+	return tf.mul(numerator, tf.MultInverse(denominator))
+}
+
+// TowerIsomorphism translates elements between a TowerField and a Field of the
+// same order. Map and Inverse preserve both addition and multiplication.
+type TowerIsomorphism[T IntLike] struct {
+	Tower *TowerField[T]
+	Flat  *Field[T]
+
+	forward bitMatrix
+	inverse bitMatrix
+}
+
+// Isomorphism returns an isomorphism between the TowerField and the given flat
+// Field of the same order. The base field is embedded in the flat field by
+// mapping its Generator to a root r of its prime polynomial, and the tower
+// variable y is mapped to a root of the modulus, whose coefficients are
+// embedded likewise.
+//
+// Panics if the fields have different orders.
+func (tf *TowerField[T]) Isomorphism(flat *Field[T]) *TowerIsomorphism[T] {
+	bitLen := tf.degree * tf.width
+	if flat.Prime.Degree() != bitLen {
+		panic(
+			fmt.Sprintf(
+				"cannot map GF((2^%d)^%d) to GF(2^%d); fields must have the same order",
+				tf.width, tf.degree, flat.Prime.Degree(),
+			),
+		)
+	}
+
+	r, ok := flat.polynomialRoot(binaryFieldPolynomial[T](tf.base.Prime))
+	if !ok {
+		panic(fmt.Sprintf("failed to find root of %s in field over %s", tf.base.Prime, flat.Prime))
+	}
+
+	baseEmbedding := make(bitMatrix, tf.width)
+	power := T(1)
+	for j := range baseEmbedding {
+		baseEmbedding[j] = uint64(power)
+		power = flat.Mul(power, r)
+	}
+
+	embeddedModulus := make(FieldPolynomial[T], len(tf.modulus))
+	for i, coeff := range tf.modulus {
+		embeddedModulus[i] = T(baseEmbedding.mulVec(uint64(coeff)))
+	}
+	y, ok := flat.polynomialRoot(embeddedModulus)
+	if !ok {
+		panic(fmt.Sprintf("failed to find root of modulus %v in field over %s", tf.modulus, flat.Prime))
+	}
+
+	// Column i*n + j is the image of x^j * y^i.
+	forward := make(bitMatrix, 0, bitLen)
+	yPower := T(1)
+	for i := uint64(0); i < tf.degree; i++ {
+		for _, column := range baseEmbedding {
+			forward = append(forward, uint64(flat.Mul(T(column), yPower)))
+		}
+		yPower = flat.Mul(yPower, y)
+	}
+	inverse, ok := forward.inverse()
+	if !ok {
+		panic(fmt.Sprintf("failed to find basis of field over %s", flat.Prime))
+	}
+
+	return &TowerIsomorphism[T]{
+		Tower:   tf,
+		Flat:    flat,
+		forward: forward,
+		inverse: inverse,
+	}
+}
+
+// Map translates the element a of the TowerField into the flat Field.
+func (iso *TowerIsomorphism[T]) Map(a T) T {
+	return T(iso.forward.mulVec(uint64(a)))
+}
+
+// Inverse translates the element b of the flat Field back into the TowerField.
+// It is the inverse of Map.
+func (iso *TowerIsomorphism[T]) Inverse(b T) T {
+	return T(iso.inverse.mulVec(uint64(b)))
+}
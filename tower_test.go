@@ -0,0 +1,117 @@
+package galois
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// quadraticModulus returns the polynomial y^2 + y + c, where c is the smallest
+// element of trace one, which is irreducible over the field.
+func quadraticModulus[T IntLike](field *Field[T]) FieldPolynomial[T] {
+	c := T(1)
+	for field.Trace(c) != 1 {
+		c++
+	}
+	return FieldPolynomial[T]{c, 1, 1}
+}
+
+func testTowerField[T IntLike](t *testing.T, tf *TowerField[T], flat *Field[T], samples int) {
+	rng := rand.New(rand.NewSource(1))
+	iso := tf.Isomorphism(flat)
+	random := func() T { return T(rng.Uint64() % tf.Order()) }
+
+	if iso.Map(0) != 0 || iso.Map(1) != 1 {
+		t.Errorf("expected Map to preserve the identity elements")
+	}
+
+	for trial := 0; trial < samples; trial++ {
+		a, b, c := random(), random(), random()
+
+		if tf.Mul(a, tf.Add(b, c)) != tf.Add(tf.Mul(a, b), tf.Mul(a, c)) {
+			t.Fatalf("failed distributivity test: %d(%d + %d)", a, b, c)
+		}
+		if iso.Map(tf.Mul(a, b)) != flat.Mul(iso.Map(a), iso.Map(b)) {
+			t.Fatalf("expected Map to preserve multiplication of %d and %d", a, b)
+		}
+		if iso.Map(tf.Add(a, b)) != flat.Add(iso.Map(a), iso.Map(b)) {
+			t.Fatalf("expected Map to preserve addition of %d and %d", a, b)
+		}
+		if iso.Inverse(iso.Map(a)) != a {
+			t.Fatalf("expected Inverse to undo Map for %d", a)
+		}
+		if a != 0 {
+			if tf.Mul(a, tf.MultInverse(a)) != 1 {
+				t.Fatalf("expected %d times its inverse to be 1", a)
+			}
+			if tf.Mul(tf.Div(b, a), a) != b {
+				t.Fatalf("expected (%d / %d) * %d = %d", b, a, a, b)
+			}
+		}
+	}
+}
+
+func TestTowerField_GF16Squared(t *testing.T) {
+	base := NewField[uint8](PrimePolynomialDegree4)
+	tf := NewTowerField(base, quadraticModulus(base))
+
+	if tf.Order() != 256 || tf.Degree() != 2 {
+		t.Errorf("expected GF((2^4)^2) to have order 256 and degree 2")
+	}
+	testTowerField(t, tf, NewField[uint8](0x11B), 2000)
+}
+
+func TestTowerField_GF256Squared(t *testing.T) {
+	base := NewField[uint16](PrimePolynomialDegree8)
+	testTowerField(t, NewTowerField(base, quadraticModulus(base)), NewField[uint16](PrimePolynomialDegree16), 500)
+}
+
+func TestTowerField_Cubic(t *testing.T) {
+	base := NewField[uint16](PrimePolynomialDegree4)
+
+	// A cubic is irreducible if and only if it has no roots.
+	var modulus FieldPolynomial[uint16]
+	for c := uint16(1); c < 16; c++ {
+		candidate := FieldPolynomial[uint16]{c, 1, 0, 1}
+		hasRoot := false
+		for x := uint16(0); x < 16; x++ {
+			if base.EvalPolynomial(candidate, x) == 0 {
+				hasRoot = true
+			}
+		}
+		if !hasRoot {
+			modulus = candidate
+			break
+		}
+	}
+
+	tf := NewTowerField(base, modulus)
+	testTowerField(t, tf, NewField[uint16](PrimePolynomialDegree12), 500)
+
+	packed := tf.Pack(FieldPolynomial[uint16]{0x3, 0xA, 0x5})
+	if packed != 0x5A3 {
+		t.Errorf("expected packed element 0x5A3, got %#x", packed)
+	}
+	if coeffs := tf.Unpack(packed); coeffs[0] != 0x3 || coeffs[1] != 0xA || coeffs[2] != 0x5 {
+		t.Errorf("expected to unpack coefficients [3 10 5], got %v", coeffs)
+	}
+}
+
+func TestNewTowerField_Reducible(t *testing.T) {
+	base := NewField[uint8](PrimePolynomialDegree4)
+
+	// The product of two distinct irreducible quadratics has no roots, but is
+	// reducible.
+	quadratic := quadraticModulus(base)
+	other := FieldPolynomial[uint8]{quadratic[0] + 1, 1, 1}
+	for base.Trace(other[0]) != 1 {
+		other[0]++
+	}
+	quartic := base.mulPolynomials(quadratic, other)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected to panic with reducible modulus %v", quartic)
+		}
+	}()
+	NewTowerField(base, quartic)
+}
@@ -0,0 +1,100 @@
+package galois
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Validation selects optional checks performed by NewValidatedField on the
+// prime polynomial of a Field. Checks can be combined with bitwise OR.
+type Validation uint8
+
+const (
+	// ValidatePrimitive checks that the prime polynomial is primitive, which is
+	// required for the Generator to generate every non-zero element of the Field,
+	// as assumed by Generate and Log.
+	ValidatePrimitive Validation = 1 << iota
+)
+
+// maxCachedTests is the largest number of results held by each testCache. Once
+// a cache is full, further results are computed but not stored.
+const maxCachedTests = 4096
+
+// Caches of the results of irreducibility and primitivity tests, which are
+// costly for large degrees.
+var (
+	irreducibleCache testCache
+	primitiveCache   testCache
+)
+
+// testCache holds the results of a test of polynomials, keyed by Polynomial,
+// up to a limit of maxCachedTests results.
+type testCache struct {
+	results sync.Map
+	size    atomic.Int64
+}
+
+// test returns the result of test(p), computing it only if it is not already
+// stored in the cache.
+func (cache *testCache) test(p Polynomial, test func(Polynomial) bool) bool {
+	if result, ok := cache.results.Load(p); ok {
+		return result.(bool)
+	}
+	result := test(p)
+
+	// Reserve space for the result before storing it, so that concurrent
+	// callers cannot exceed the limit.
+	if cache.size.Add(1) <= maxCachedTests {
+		if _, loaded := cache.results.LoadOrStore(p, result); !loaded {
+			return result
+		}
+	}
+	cache.size.Add(-1)
+	return result
+}
+
+// NewValidatedField creates a Field generated by the given prime polynomial, like
+// NewField, after checking that the prime polynomial can be used to construct a
+// working Field. It always rejects:
+//
+//   - a prime of degree 0 or 1, including the zero polynomial, with an error
+//     wrapping ErrInvalidPrime.
+//   - a prime of degree greater than 32, whose products overflow
+//     Polynomial.Mul, with an error wrapping ErrOverflow.
+//   - a prime for which the type parameter T is not of sufficient size to
+//     represent every element in the field, with an error wrapping ErrOverflow.
+//   - a prime which is not irreducible, without which some non-zero elements
+//     have no inverse, with an error wrapping ErrNotIrreducible.
+//
+// The checks argument selects further validation of the prime. If
+// ValidatePrimitive is set, a prime which is irreducible but not primitive is
+// rejected with an error wrapping ErrNotPrimitive. The results of the
+// irreducibility and primitivity tests are cached, so that constructing many
+// Fields over the same prime does not repeat them, for up to 4096 distinct
+// primes.
+func NewValidatedField[T IntLike](prime Polynomial, checks Validation) (*Field[T], error) {
+	degree := prime.Degree()
+	if degree <= 1 {
+		return nil, fmt.Errorf("%w: prime polynomial has degree %d; minimum degree is 2", ErrInvalidPrime, degree)
+	}
+	if degree > 32 {
+		return nil, fmt.Errorf("%w: prime polynomial has degree %d; maximum degree is 32", ErrOverflow, degree)
+	}
+
+	if err := checkTypeSize[T](prime); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrOverflow, err)
+	}
+
+	if !irreducibleCache.test(prime, Polynomial.IsIrreducible) {
+		return nil, fmt.Errorf("%w: cannot create field over %s", ErrNotIrreducible, prime)
+	}
+	if checks&ValidatePrimitive != 0 && !primitiveCache.test(prime, Polynomial.IsPrimitive) {
+		return nil, fmt.Errorf(
+			"%w: the Generator x does not generate every element of the field over %s",
+			ErrNotPrimitive, prime,
+		)
+	}
+
+	return NewField[T](prime), nil
+}
@@ -0,0 +1,78 @@
+package galois
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewValidatedField(t *testing.T) {
+	type TestCase struct {
+		Prime  Polynomial
+		Checks Validation
+		Err    error
+	}
+
+	testCases := []TestCase{
+		{Prime: PrimePolynomialDegree8, Checks: 0, Err: nil},
+		{Prime: PrimePolynomialDegree8, Checks: ValidatePrimitive, Err: nil},
+		{Prime: PrimePolynomialDegree16, Checks: ValidatePrimitive, Err: nil},
+		{Prime: 0, Checks: 0, Err: ErrInvalidPrime},
+		{Prime: 1, Checks: 0, Err: ErrInvalidPrime},
+		{Prime: 0b11, Checks: 0, Err: ErrInvalidPrime},
+		{Prime: 1 << 33, Checks: 0, Err: ErrOverflow},
+		{Prime: PrimePolynomialDegree17, Checks: 0, Err: ErrOverflow}, // too big for uint16
+
+		// x^8 + 1 = (x + 1)^8 is reducible.
+		{Prime: 0x101, Checks: 0, Err: ErrNotIrreducible},
+		{Prime: 0x101, Checks: ValidatePrimitive, Err: ErrNotIrreducible},
+
+		// The AES polynomial is irreducible, but x has order 51.
+		{Prime: 0x11B, Checks: 0, Err: nil},
+		{Prime: 0x11B, Checks: ValidatePrimitive, Err: ErrNotPrimitive},
+	}
+
+	for _, test := range testCases {
+		// Run twice, so that the second run uses cached results.
+		for run := 0; run < 2; run++ {
+			field, err := NewValidatedField[uint16](test.Prime, test.Checks)
+			if !errors.Is(err, test.Err) || (test.Err != nil) != (err != nil) {
+				t.Errorf("expected NewValidatedField(%s, %d) to return error %v, got %v", test.Prime, test.Checks, test.Err, err)
+				continue
+			}
+			if err == nil && field.Mul(1, 1) != 1 {
+				t.Errorf("expected working field over %s", test.Prime)
+			}
+		}
+	}
+
+	if cached, ok := primitiveCache.results.Load(Polynomial(0x11B)); !ok || cached.(bool) {
+		t.Errorf("expected primitivity of 0x11B to be cached as false")
+	}
+}
+
+func TestTestCache_Limit(t *testing.T) {
+	var cache testCache
+
+	calls := 0
+	test := func(p Polynomial) bool {
+		calls++
+		return p.IsIrreducible()
+	}
+
+	for p := Polynomial(0); p < maxCachedTests+100; p++ {
+		if cache.test(p, test) != p.IsIrreducible() {
+			t.Fatalf("expected cached result of test of %s to match", p)
+		}
+	}
+	if size := cache.size.Load(); size != maxCachedTests {
+		t.Errorf("expected cache to hold %d results, got %d", maxCachedTests, size)
+	}
+
+	// Cached results are not recomputed, while results beyond the limit are.
+	calls = 0
+	cache.test(1, test)
+	cache.test(maxCachedTests+1, test)
+	if calls != 1 {
+		t.Errorf("expected one test to be recomputed, got %d", calls)
+	}
+}